@@ -34,17 +34,24 @@ func (n Indent) String() string {
 	return buf.String()
 }
 
-// HasIndent returns true if the Inc is > 0 and Tab != ''.
+// HasIndent returns true if the Inc is > 0 and Tab != "".
 func (n Indent) HasIndent() bool {
 	noIndent := n.Inc == 0 && n.Tab == ""
 	return !noIndent
 }
 
-// WriteTo outputs the Indent to the Writer.
-func (n Indent) WriteTo(w io.Writer) {
+// WriteTo outputs the Indent to the Writer, returning the number of bytes
+// written and the first error encountered.
+func (n Indent) WriteTo(w io.Writer) (int64, error) {
+	var total int64
 	for i := 0; i < n.Level; i++ {
-		w.Write([]byte(n.Tab))
+		c, err := io.WriteString(w, n.Tab)
+		total += int64(c)
+		if err != nil {
+			return total, err
+		}
 	}
+	return total, nil
 }
 
 // Incr adds one level to the Indent.
@@ -0,0 +1,146 @@
+---
+# Attributes taking a value.
+attrs:
+  - Accept
+  - AcceptCharset accept-charset
+  - Accesskey
+  - Action
+  - Allow
+  - Alt
+  - As
+  - Autocapitalize
+  - Autocomplete
+  - Blocking
+  - Charset
+  - Cite
+  - Cols
+  - Colspan
+  - Content
+  - Contenteditable
+  - Coords
+  - Crossorigin
+  - Datetime
+  - Decoding
+  - Dir
+  - Dirname
+  - Download
+  - Draggable
+  - Enctype
+  - Enterkeyhint
+  - Fetchpriority
+  - For
+  - Form
+  - Formaction
+  - Formenctype
+  - Formmethod
+  - Formtarget
+  - Headers
+  - Height
+  - High
+  - Href
+  - Hreflang
+  - HttpEquiv http-equiv
+  - ID
+  - Imagesizes
+  - Imagesrcset
+  - Inputmode
+  - Integrity
+  - Is
+  - Itemid
+  - Itemprop
+  - Itemref
+  - Itemtype
+  - Kind
+  - Label
+  - Lang
+  - List
+  - Loading
+  - Low
+  - Max
+  - Maxlength
+  - Media
+  - Method
+  - Min
+  - Minlength
+  - Name
+  - Nonce
+  - Optimum
+  - Pattern
+  - Ping
+  - Placeholder
+  - Popover
+  - Popovertarget
+  - Popovertargetaction
+  - Poster
+  - Preload
+  - Referrerpolicy
+  - Rel
+  - Role
+  - Rows
+  - Rowspan
+  - Sandbox
+  - Scope
+  - Shadowrootmode
+  - Shape
+  - Size
+  - Sizes
+  - Slot
+  - Span
+  - Spellcheck
+  - Src
+  - Srcdoc
+  - Srclang
+  - Srcset
+  - Start
+  - Step
+  - Tabindex
+  - Target
+  - Title
+  - Translate
+  - Type
+  - Usemap
+  - Value
+  - Width
+  - Wrap
+# Boolean attributes, which are either present or absent.
+bool:
+  - Allowfullscreen
+  - Async
+  - Autofocus
+  - Autoplay
+  - Checked
+  - Controls
+  - Default
+  - Defer
+  - Disabled
+  - Formnovalidate
+  - Hidden
+  - Inert
+  - Ismap
+  - Itemscope
+  - Loop
+  - Multiple
+  - Muted
+  - Nomodule
+  - Novalidate
+  - Open
+  - Playsinline
+  - Readonly
+  - Required
+  - Reversed
+  - Selected
+---
+{{ .GEN_TAGLINE }}
+
+package gel
+{{ range .attrs }}
+// {{ ident . }} adds the {{ name . }} attribute with the given value.
+func (t Tag) {{ ident . }}(value string) Tag {
+  return t.Atts("{{ name . }}", value)
+}
+{{ end }}{{ range .bool }}
+// {{ ident . }} adds the boolean {{ name . }} attribute.
+func (t Tag) {{ ident . }}() Tag {
+  return t.Bool("{{ name . }}")
+}
+{{ end }}
\ No newline at end of file
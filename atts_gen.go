@@ -0,0 +1,633 @@
+// Code generated by gentags from atts.go.tpl; DO NOT EDIT.
+
+package gel
+
+// Accept adds the accept attribute with the given value.
+func (t Tag) Accept(value string) Tag {
+	return t.Atts("accept", value)
+}
+
+// AcceptCharset adds the accept-charset attribute with the given value.
+func (t Tag) AcceptCharset(value string) Tag {
+	return t.Atts("accept-charset", value)
+}
+
+// Accesskey adds the accesskey attribute with the given value.
+func (t Tag) Accesskey(value string) Tag {
+	return t.Atts("accesskey", value)
+}
+
+// Action adds the action attribute with the given value.
+func (t Tag) Action(value string) Tag {
+	return t.Atts("action", value)
+}
+
+// Allow adds the allow attribute with the given value.
+func (t Tag) Allow(value string) Tag {
+	return t.Atts("allow", value)
+}
+
+// Alt adds the alt attribute with the given value.
+func (t Tag) Alt(value string) Tag {
+	return t.Atts("alt", value)
+}
+
+// As adds the as attribute with the given value.
+func (t Tag) As(value string) Tag {
+	return t.Atts("as", value)
+}
+
+// Autocapitalize adds the autocapitalize attribute with the given value.
+func (t Tag) Autocapitalize(value string) Tag {
+	return t.Atts("autocapitalize", value)
+}
+
+// Autocomplete adds the autocomplete attribute with the given value.
+func (t Tag) Autocomplete(value string) Tag {
+	return t.Atts("autocomplete", value)
+}
+
+// Blocking adds the blocking attribute with the given value.
+func (t Tag) Blocking(value string) Tag {
+	return t.Atts("blocking", value)
+}
+
+// Charset adds the charset attribute with the given value.
+func (t Tag) Charset(value string) Tag {
+	return t.Atts("charset", value)
+}
+
+// Cite adds the cite attribute with the given value.
+func (t Tag) Cite(value string) Tag {
+	return t.Atts("cite", value)
+}
+
+// Cols adds the cols attribute with the given value.
+func (t Tag) Cols(value string) Tag {
+	return t.Atts("cols", value)
+}
+
+// Colspan adds the colspan attribute with the given value.
+func (t Tag) Colspan(value string) Tag {
+	return t.Atts("colspan", value)
+}
+
+// Content adds the content attribute with the given value.
+func (t Tag) Content(value string) Tag {
+	return t.Atts("content", value)
+}
+
+// Contenteditable adds the contenteditable attribute with the given value.
+func (t Tag) Contenteditable(value string) Tag {
+	return t.Atts("contenteditable", value)
+}
+
+// Coords adds the coords attribute with the given value.
+func (t Tag) Coords(value string) Tag {
+	return t.Atts("coords", value)
+}
+
+// Crossorigin adds the crossorigin attribute with the given value.
+func (t Tag) Crossorigin(value string) Tag {
+	return t.Atts("crossorigin", value)
+}
+
+// Datetime adds the datetime attribute with the given value.
+func (t Tag) Datetime(value string) Tag {
+	return t.Atts("datetime", value)
+}
+
+// Decoding adds the decoding attribute with the given value.
+func (t Tag) Decoding(value string) Tag {
+	return t.Atts("decoding", value)
+}
+
+// Dir adds the dir attribute with the given value.
+func (t Tag) Dir(value string) Tag {
+	return t.Atts("dir", value)
+}
+
+// Dirname adds the dirname attribute with the given value.
+func (t Tag) Dirname(value string) Tag {
+	return t.Atts("dirname", value)
+}
+
+// Download adds the download attribute with the given value.
+func (t Tag) Download(value string) Tag {
+	return t.Atts("download", value)
+}
+
+// Draggable adds the draggable attribute with the given value.
+func (t Tag) Draggable(value string) Tag {
+	return t.Atts("draggable", value)
+}
+
+// Enctype adds the enctype attribute with the given value.
+func (t Tag) Enctype(value string) Tag {
+	return t.Atts("enctype", value)
+}
+
+// Enterkeyhint adds the enterkeyhint attribute with the given value.
+func (t Tag) Enterkeyhint(value string) Tag {
+	return t.Atts("enterkeyhint", value)
+}
+
+// Fetchpriority adds the fetchpriority attribute with the given value.
+func (t Tag) Fetchpriority(value string) Tag {
+	return t.Atts("fetchpriority", value)
+}
+
+// For adds the for attribute with the given value.
+func (t Tag) For(value string) Tag {
+	return t.Atts("for", value)
+}
+
+// Form adds the form attribute with the given value.
+func (t Tag) Form(value string) Tag {
+	return t.Atts("form", value)
+}
+
+// Formaction adds the formaction attribute with the given value.
+func (t Tag) Formaction(value string) Tag {
+	return t.Atts("formaction", value)
+}
+
+// Formenctype adds the formenctype attribute with the given value.
+func (t Tag) Formenctype(value string) Tag {
+	return t.Atts("formenctype", value)
+}
+
+// Formmethod adds the formmethod attribute with the given value.
+func (t Tag) Formmethod(value string) Tag {
+	return t.Atts("formmethod", value)
+}
+
+// Formtarget adds the formtarget attribute with the given value.
+func (t Tag) Formtarget(value string) Tag {
+	return t.Atts("formtarget", value)
+}
+
+// Headers adds the headers attribute with the given value.
+func (t Tag) Headers(value string) Tag {
+	return t.Atts("headers", value)
+}
+
+// Height adds the height attribute with the given value.
+func (t Tag) Height(value string) Tag {
+	return t.Atts("height", value)
+}
+
+// High adds the high attribute with the given value.
+func (t Tag) High(value string) Tag {
+	return t.Atts("high", value)
+}
+
+// Href adds the href attribute with the given value.
+func (t Tag) Href(value string) Tag {
+	return t.Atts("href", value)
+}
+
+// Hreflang adds the hreflang attribute with the given value.
+func (t Tag) Hreflang(value string) Tag {
+	return t.Atts("hreflang", value)
+}
+
+// HttpEquiv adds the http-equiv attribute with the given value.
+func (t Tag) HttpEquiv(value string) Tag {
+	return t.Atts("http-equiv", value)
+}
+
+// ID adds the id attribute with the given value.
+func (t Tag) ID(value string) Tag {
+	return t.Atts("id", value)
+}
+
+// Imagesizes adds the imagesizes attribute with the given value.
+func (t Tag) Imagesizes(value string) Tag {
+	return t.Atts("imagesizes", value)
+}
+
+// Imagesrcset adds the imagesrcset attribute with the given value.
+func (t Tag) Imagesrcset(value string) Tag {
+	return t.Atts("imagesrcset", value)
+}
+
+// Inputmode adds the inputmode attribute with the given value.
+func (t Tag) Inputmode(value string) Tag {
+	return t.Atts("inputmode", value)
+}
+
+// Integrity adds the integrity attribute with the given value.
+func (t Tag) Integrity(value string) Tag {
+	return t.Atts("integrity", value)
+}
+
+// Is adds the is attribute with the given value.
+func (t Tag) Is(value string) Tag {
+	return t.Atts("is", value)
+}
+
+// Itemid adds the itemid attribute with the given value.
+func (t Tag) Itemid(value string) Tag {
+	return t.Atts("itemid", value)
+}
+
+// Itemprop adds the itemprop attribute with the given value.
+func (t Tag) Itemprop(value string) Tag {
+	return t.Atts("itemprop", value)
+}
+
+// Itemref adds the itemref attribute with the given value.
+func (t Tag) Itemref(value string) Tag {
+	return t.Atts("itemref", value)
+}
+
+// Itemtype adds the itemtype attribute with the given value.
+func (t Tag) Itemtype(value string) Tag {
+	return t.Atts("itemtype", value)
+}
+
+// Kind adds the kind attribute with the given value.
+func (t Tag) Kind(value string) Tag {
+	return t.Atts("kind", value)
+}
+
+// Label adds the label attribute with the given value.
+func (t Tag) Label(value string) Tag {
+	return t.Atts("label", value)
+}
+
+// Lang adds the lang attribute with the given value.
+func (t Tag) Lang(value string) Tag {
+	return t.Atts("lang", value)
+}
+
+// List adds the list attribute with the given value.
+func (t Tag) List(value string) Tag {
+	return t.Atts("list", value)
+}
+
+// Loading adds the loading attribute with the given value.
+func (t Tag) Loading(value string) Tag {
+	return t.Atts("loading", value)
+}
+
+// Low adds the low attribute with the given value.
+func (t Tag) Low(value string) Tag {
+	return t.Atts("low", value)
+}
+
+// Max adds the max attribute with the given value.
+func (t Tag) Max(value string) Tag {
+	return t.Atts("max", value)
+}
+
+// Maxlength adds the maxlength attribute with the given value.
+func (t Tag) Maxlength(value string) Tag {
+	return t.Atts("maxlength", value)
+}
+
+// Media adds the media attribute with the given value.
+func (t Tag) Media(value string) Tag {
+	return t.Atts("media", value)
+}
+
+// Method adds the method attribute with the given value.
+func (t Tag) Method(value string) Tag {
+	return t.Atts("method", value)
+}
+
+// Min adds the min attribute with the given value.
+func (t Tag) Min(value string) Tag {
+	return t.Atts("min", value)
+}
+
+// Minlength adds the minlength attribute with the given value.
+func (t Tag) Minlength(value string) Tag {
+	return t.Atts("minlength", value)
+}
+
+// Name adds the name attribute with the given value.
+func (t Tag) Name(value string) Tag {
+	return t.Atts("name", value)
+}
+
+// Nonce adds the nonce attribute with the given value.
+func (t Tag) Nonce(value string) Tag {
+	return t.Atts("nonce", value)
+}
+
+// Optimum adds the optimum attribute with the given value.
+func (t Tag) Optimum(value string) Tag {
+	return t.Atts("optimum", value)
+}
+
+// Pattern adds the pattern attribute with the given value.
+func (t Tag) Pattern(value string) Tag {
+	return t.Atts("pattern", value)
+}
+
+// Ping adds the ping attribute with the given value.
+func (t Tag) Ping(value string) Tag {
+	return t.Atts("ping", value)
+}
+
+// Placeholder adds the placeholder attribute with the given value.
+func (t Tag) Placeholder(value string) Tag {
+	return t.Atts("placeholder", value)
+}
+
+// Popover adds the popover attribute with the given value.
+func (t Tag) Popover(value string) Tag {
+	return t.Atts("popover", value)
+}
+
+// Popovertarget adds the popovertarget attribute with the given value.
+func (t Tag) Popovertarget(value string) Tag {
+	return t.Atts("popovertarget", value)
+}
+
+// Popovertargetaction adds the popovertargetaction attribute with the given value.
+func (t Tag) Popovertargetaction(value string) Tag {
+	return t.Atts("popovertargetaction", value)
+}
+
+// Poster adds the poster attribute with the given value.
+func (t Tag) Poster(value string) Tag {
+	return t.Atts("poster", value)
+}
+
+// Preload adds the preload attribute with the given value.
+func (t Tag) Preload(value string) Tag {
+	return t.Atts("preload", value)
+}
+
+// Referrerpolicy adds the referrerpolicy attribute with the given value.
+func (t Tag) Referrerpolicy(value string) Tag {
+	return t.Atts("referrerpolicy", value)
+}
+
+// Rel adds the rel attribute with the given value.
+func (t Tag) Rel(value string) Tag {
+	return t.Atts("rel", value)
+}
+
+// Role adds the role attribute with the given value.
+func (t Tag) Role(value string) Tag {
+	return t.Atts("role", value)
+}
+
+// Rows adds the rows attribute with the given value.
+func (t Tag) Rows(value string) Tag {
+	return t.Atts("rows", value)
+}
+
+// Rowspan adds the rowspan attribute with the given value.
+func (t Tag) Rowspan(value string) Tag {
+	return t.Atts("rowspan", value)
+}
+
+// Sandbox adds the sandbox attribute with the given value.
+func (t Tag) Sandbox(value string) Tag {
+	return t.Atts("sandbox", value)
+}
+
+// Scope adds the scope attribute with the given value.
+func (t Tag) Scope(value string) Tag {
+	return t.Atts("scope", value)
+}
+
+// Shadowrootmode adds the shadowrootmode attribute with the given value.
+func (t Tag) Shadowrootmode(value string) Tag {
+	return t.Atts("shadowrootmode", value)
+}
+
+// Shape adds the shape attribute with the given value.
+func (t Tag) Shape(value string) Tag {
+	return t.Atts("shape", value)
+}
+
+// Size adds the size attribute with the given value.
+func (t Tag) Size(value string) Tag {
+	return t.Atts("size", value)
+}
+
+// Sizes adds the sizes attribute with the given value.
+func (t Tag) Sizes(value string) Tag {
+	return t.Atts("sizes", value)
+}
+
+// Slot adds the slot attribute with the given value.
+func (t Tag) Slot(value string) Tag {
+	return t.Atts("slot", value)
+}
+
+// Span adds the span attribute with the given value.
+func (t Tag) Span(value string) Tag {
+	return t.Atts("span", value)
+}
+
+// Spellcheck adds the spellcheck attribute with the given value.
+func (t Tag) Spellcheck(value string) Tag {
+	return t.Atts("spellcheck", value)
+}
+
+// Src adds the src attribute with the given value.
+func (t Tag) Src(value string) Tag {
+	return t.Atts("src", value)
+}
+
+// Srcdoc adds the srcdoc attribute with the given value.
+func (t Tag) Srcdoc(value string) Tag {
+	return t.Atts("srcdoc", value)
+}
+
+// Srclang adds the srclang attribute with the given value.
+func (t Tag) Srclang(value string) Tag {
+	return t.Atts("srclang", value)
+}
+
+// Srcset adds the srcset attribute with the given value.
+func (t Tag) Srcset(value string) Tag {
+	return t.Atts("srcset", value)
+}
+
+// Start adds the start attribute with the given value.
+func (t Tag) Start(value string) Tag {
+	return t.Atts("start", value)
+}
+
+// Step adds the step attribute with the given value.
+func (t Tag) Step(value string) Tag {
+	return t.Atts("step", value)
+}
+
+// Tabindex adds the tabindex attribute with the given value.
+func (t Tag) Tabindex(value string) Tag {
+	return t.Atts("tabindex", value)
+}
+
+// Target adds the target attribute with the given value.
+func (t Tag) Target(value string) Tag {
+	return t.Atts("target", value)
+}
+
+// Title adds the title attribute with the given value.
+func (t Tag) Title(value string) Tag {
+	return t.Atts("title", value)
+}
+
+// Translate adds the translate attribute with the given value.
+func (t Tag) Translate(value string) Tag {
+	return t.Atts("translate", value)
+}
+
+// Type adds the type attribute with the given value.
+func (t Tag) Type(value string) Tag {
+	return t.Atts("type", value)
+}
+
+// Usemap adds the usemap attribute with the given value.
+func (t Tag) Usemap(value string) Tag {
+	return t.Atts("usemap", value)
+}
+
+// Value adds the value attribute with the given value.
+func (t Tag) Value(value string) Tag {
+	return t.Atts("value", value)
+}
+
+// Width adds the width attribute with the given value.
+func (t Tag) Width(value string) Tag {
+	return t.Atts("width", value)
+}
+
+// Wrap adds the wrap attribute with the given value.
+func (t Tag) Wrap(value string) Tag {
+	return t.Atts("wrap", value)
+}
+
+// Allowfullscreen adds the boolean allowfullscreen attribute.
+func (t Tag) Allowfullscreen() Tag {
+	return t.Bool("allowfullscreen")
+}
+
+// Async adds the boolean async attribute.
+func (t Tag) Async() Tag {
+	return t.Bool("async")
+}
+
+// Autofocus adds the boolean autofocus attribute.
+func (t Tag) Autofocus() Tag {
+	return t.Bool("autofocus")
+}
+
+// Autoplay adds the boolean autoplay attribute.
+func (t Tag) Autoplay() Tag {
+	return t.Bool("autoplay")
+}
+
+// Checked adds the boolean checked attribute.
+func (t Tag) Checked() Tag {
+	return t.Bool("checked")
+}
+
+// Controls adds the boolean controls attribute.
+func (t Tag) Controls() Tag {
+	return t.Bool("controls")
+}
+
+// Default adds the boolean default attribute.
+func (t Tag) Default() Tag {
+	return t.Bool("default")
+}
+
+// Defer adds the boolean defer attribute.
+func (t Tag) Defer() Tag {
+	return t.Bool("defer")
+}
+
+// Disabled adds the boolean disabled attribute.
+func (t Tag) Disabled() Tag {
+	return t.Bool("disabled")
+}
+
+// Formnovalidate adds the boolean formnovalidate attribute.
+func (t Tag) Formnovalidate() Tag {
+	return t.Bool("formnovalidate")
+}
+
+// Hidden adds the boolean hidden attribute.
+func (t Tag) Hidden() Tag {
+	return t.Bool("hidden")
+}
+
+// Inert adds the boolean inert attribute.
+func (t Tag) Inert() Tag {
+	return t.Bool("inert")
+}
+
+// Ismap adds the boolean ismap attribute.
+func (t Tag) Ismap() Tag {
+	return t.Bool("ismap")
+}
+
+// Itemscope adds the boolean itemscope attribute.
+func (t Tag) Itemscope() Tag {
+	return t.Bool("itemscope")
+}
+
+// Loop adds the boolean loop attribute.
+func (t Tag) Loop() Tag {
+	return t.Bool("loop")
+}
+
+// Multiple adds the boolean multiple attribute.
+func (t Tag) Multiple() Tag {
+	return t.Bool("multiple")
+}
+
+// Muted adds the boolean muted attribute.
+func (t Tag) Muted() Tag {
+	return t.Bool("muted")
+}
+
+// Nomodule adds the boolean nomodule attribute.
+func (t Tag) Nomodule() Tag {
+	return t.Bool("nomodule")
+}
+
+// Novalidate adds the boolean novalidate attribute.
+func (t Tag) Novalidate() Tag {
+	return t.Bool("novalidate")
+}
+
+// Open adds the boolean open attribute.
+func (t Tag) Open() Tag {
+	return t.Bool("open")
+}
+
+// Playsinline adds the boolean playsinline attribute.
+func (t Tag) Playsinline() Tag {
+	return t.Bool("playsinline")
+}
+
+// Readonly adds the boolean readonly attribute.
+func (t Tag) Readonly() Tag {
+	return t.Bool("readonly")
+}
+
+// Required adds the boolean required attribute.
+func (t Tag) Required() Tag {
+	return t.Bool("required")
+}
+
+// Reversed adds the boolean reversed attribute.
+func (t Tag) Reversed() Tag {
+	return t.Bool("reversed")
+}
+
+// Selected adds the boolean selected attribute.
+func (t Tag) Selected() Tag {
+	return t.Bool("selected")
+}
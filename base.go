@@ -1,5 +1,8 @@
 package gel
 
+//go:generate go run ./cmd/gentags -in tags.go.tpl -out tags_gen.go
+//go:generate go run ./cmd/gentags -in atts.go.tpl -out atts_gen.go
+
 // Tag is the starting point of an element.
 type Tag func(...View) View
 
@@ -28,18 +31,34 @@ func El(tag string, isVoid bool) Tag {
 	}
 }
 
-// Adds the class attribute with the given value
-func (t Tag) Class(class string) Tag {
-	return t.Atts("class", class)
+// Class adds the class attribute with the given class names.
+func (t Tag) Class(names ...string) Tag {
+	return t.With(Class(names...))
 }
 
-// Atts creates a tag with the given pairs of Attributes.
-func (t Tag) Atts(pairs ...string) Tag {
+// With creates a tag which always starts with the given views, such as
+// attributes, before the children it is later given.
+func (t Tag) With(first ...View) Tag {
 	return func(views ...View) View {
-		atts := []View{Atts(pairs...)}
-		atts = append(atts, views...)
-		return t(atts...)
+		all := make([]View, 0, len(first)+len(views))
+		all = append(all, first...)
+		all = append(all, views...)
+		return t(all...)
+	}
+}
+
+// Bool creates a tag with the given boolean Attributes.
+func (t Tag) Bool(keys ...string) Tag {
+	atts := make([]View, 0, len(keys))
+	for _, key := range keys {
+		atts = append(atts, Bool(key))
 	}
+	return t.With(atts...)
+}
+
+// Atts creates a tag with the given pairs of Attributes.
+func (t Tag) Atts(pairs ...string) Tag {
+	return t.With(Atts(pairs...))
 }
 
 // Text will create an Element Node from the Tag and then immediately add the
@@ -0,0 +1,139 @@
+package gel
+
+import (
+	"sort"
+	"strings"
+)
+
+// Classes maps class names to whether they apply, which makes it simple to
+// build a class list conditionally: Classes{"active": isActive}.  It renders
+// the names that are true as a single class attribute, in sorted order.
+type Classes map[string]bool
+
+// ToNode implements the View interface producing the class Attribute, or
+// an empty AttributeList when no class applies.
+func (c Classes) ToNode() *Node {
+	names := make([]string, 0, len(c))
+	for name, on := range c {
+		if on {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return Class(names...).ToNode()
+}
+
+// Class creates a class Attribute holding each of the class names once,
+// where each name may itself be a space separated list of names.  Without
+// any names it produces an empty AttributeList.
+func Class(names ...string) View {
+	value := normalizeClass(names...)
+	if value == "" {
+		return Atts()
+	}
+	return Att("class", value)
+}
+
+// Classes creates a tag with the class names that are true in the map.
+func (t Tag) Classes(c Classes) Tag {
+	return t.With(c)
+}
+
+// RemoveClass creates a tag which removes the class names from the Element
+// once it has been built.
+func (t Tag) RemoveClass(names ...string) Tag {
+	return func(views ...View) View {
+		return t(views...).ToNode().RemoveClass(names...)
+	}
+}
+
+// ToggleClass creates a tag which toggles the class name on the Element
+// once it has been built.
+func (t Tag) ToggleClass(name string) Tag {
+	return func(views ...View) View {
+		return t(views...).ToNode().ToggleClass(name)
+	}
+}
+
+// ClassList returns the class names of the Node.
+func (e *Node) ClassList() []string {
+	att := e.classAttribute()
+	if att == nil {
+		return nil
+	}
+	return strings.Fields(att.Value)
+}
+
+// HasClass reports whether the Node has the class name.
+func (e *Node) HasClass(name string) bool {
+	return contains(e.ClassList(), name)
+}
+
+// AddClass adds the class names to the Node.
+func (e *Node) AddClass(names ...string) *Node {
+	e.Add(Class(names...))
+	return e
+}
+
+// RemoveClass removes the class names from the Node, removing the class
+// attribute entirely once it is empty.
+func (e *Node) RemoveClass(names ...string) *Node {
+	remove := strings.Fields(strings.Join(names, " "))
+	kept := make([]string, 0)
+	for _, name := range e.ClassList() {
+		if !contains(remove, name) {
+			kept = append(kept, name)
+		}
+	}
+	e.setClass(kept)
+	return e
+}
+
+// ToggleClass removes the class name if the Node has it and otherwise adds
+// it.
+func (e *Node) ToggleClass(name string) *Node {
+	if e.HasClass(name) {
+		return e.RemoveClass(name)
+	}
+	return e.AddClass(name)
+}
+
+// classAttribute returns the class Attribute of the Node, or nil.
+func (e *Node) classAttribute() *Node {
+	for _, att := range e.Attributes {
+		if strings.EqualFold(att.Key, "class") {
+			return att
+		}
+	}
+	return nil
+}
+
+// setClass replaces the class Attribute with one holding the names.  The
+// Attribute is replaced rather than changed since it may be shared.
+func (e *Node) setClass(names []string) {
+	for i, att := range e.Attributes {
+		if !strings.EqualFold(att.Key, "class") {
+			continue
+		}
+		if len(names) == 0 {
+			e.Attributes = append(e.Attributes[:i:i], e.Attributes[i+1:]...)
+			return
+		}
+		e.Attributes[i] = Att(att.Key, strings.Join(names, " ")).ToNode()
+		return
+	}
+}
+
+// normalizeClass joins the class names with single spaces, dropping
+// duplicates.
+func normalizeClass(names ...string) string {
+	tokens := make([]string, 0, len(names))
+	for _, name := range names {
+		for _, tok := range strings.Fields(name) {
+			if !contains(tokens, tok) {
+				tokens = append(tokens, tok)
+			}
+		}
+	}
+	return strings.Join(tokens, " ")
+}
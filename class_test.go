@@ -0,0 +1,48 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClass(t *testing.T) {
+
+	Convey(`Class should normalize the names into a single attribute`, t, func() {
+		s := Div.Class("btn  btn-lg", "btn", " active ").Text("ok").ToNode().String()
+		So(s, ShouldEqual, `<div class="btn btn-lg active">ok</div>`)
+		So(Div.Class()().ToNode().Attributes, ShouldHaveLength, 0)
+	})
+
+	Convey(`Classes should render the true names in sorted order`, t, func() {
+		isActive, isDisabled := true, false
+		s := Li.Class("item").Classes(Classes{"active": isActive, "disabled": isDisabled, "big": true})().ToNode().String()
+		So(s, ShouldEqual, `<li class="item active big"></li>`)
+		So(Div(Classes{"x": false}).ToNode().String(), ShouldEqual, `<div></div>`)
+	})
+
+	Convey(`Class names can be added, removed and toggled on a Node`, t, func() {
+		d := Div.Class("a b")().ToNode()
+		d.AddClass("c", "a")
+		So(d.ClassList(), ShouldResemble, []string{"a", "b", "c"})
+		d.RemoveClass("b")
+		So(d.HasClass("b"), ShouldBeFalse)
+		d.ToggleClass("a").ToggleClass("d")
+		So(d.String(), ShouldEqual, `<div class="c d"></div>`)
+		d.RemoveClass("c d")
+		So(d.Attributes, ShouldHaveLength, 0)
+		So(d.ClassList(), ShouldBeEmpty)
+	})
+
+	Convey(`Class names can be removed and toggled on a Tag`, t, func() {
+		btn := Button.Class("btn", "primary")
+		s := btn.RemoveClass("primary").ToggleClass("link").Text("go").ToNode().String()
+		So(s, ShouldEqual, `<button class="btn link">go</button>`)
+		So(btn.Text("go").ToNode().String(), ShouldEqual, `<button class="btn primary">go</button>`)
+	})
+
+	Convey(`Class names should merge with class attributes from Atts and Att`, t, func() {
+		s := Div.Atts("class", "a")(Class("b"), Att("class", "a c")).ToNode().String()
+		So(s, ShouldEqual, `<div class="a b c"></div>`)
+	})
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const separator = "---"
+
+// ErrNoFrontMatter is returned when a template doesn't start with front
+// matter enclosed by "---" lines.
+var ErrNoFrontMatter = errors.New("template must start with front matter between '---' lines")
+
+// Groups holds the tag names of each group in the order they are listed.
+type Groups map[string][]string
+
+// Generate executes the template file with the groups from its front
+// matter merged with those of the extra files, returning gofmt'd source.
+func Generate(in string, extra ...string) ([]byte, error) {
+	b, err := ioutil.ReadFile(in)
+	if err != nil {
+		return nil, err
+	}
+	front, body, err := split(string(b))
+	if err != nil {
+		return nil, err
+	}
+	groups, err := ParseGroups(front)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range extra {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		more, err := ParseGroups(string(b))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		for name, tags := range more {
+			groups[name] = append(groups[name], tags...)
+		}
+	}
+
+	tpl, err := template.New(filepath.Base(in)).Funcs(funcs).Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"GEN_TAGLINE": fmt.Sprintf("// Code generated by gentags from %s; DO NOT EDIT.", filepath.Base(in)),
+	}
+	for name, tags := range groups {
+		data[name] = tags
+	}
+	buf := bytes.NewBuffer([]byte{})
+	if err := tpl.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// funcs are available to templates.  Tags in a group may be listed as an
+// identifier followed by a name, "HttpEquiv http-equiv", for names which
+// aren't the identifier in lower case, which ident and name pull apart.
+// Case sensitive vocabularies like svg use camel, which defaults the name
+// to the identifier with only its first letter in lower case.
+var funcs = template.FuncMap{
+	"lower": strings.ToLower,
+	"ident": ident,
+	"name":  name,
+	"camel": camel,
+}
+
+// ident returns the Go identifier of the tag.
+func ident(tag string) string {
+	return strings.Fields(tag)[0]
+}
+
+// name returns the name given for the tag, which defaults to its
+// identifier in lower case.
+func name(tag string) string {
+	fields := strings.Fields(tag)
+	if len(fields) > 1 {
+		return fields[1]
+	}
+	return strings.ToLower(fields[0])
+}
+
+// camel returns the name given for the tag, which defaults to its
+// identifier with the first letter in lower case, so LinearGradient is
+// named linearGradient.
+func camel(tag string) string {
+	fields := strings.Fields(tag)
+	if len(fields) > 1 {
+		return fields[1]
+	}
+	return strings.ToLower(fields[0][:1]) + fields[0][1:]
+}
+
+// split separates the front matter from the body of the template.
+func split(src string) (string, string, error) {
+	lines := strings.SplitAfter(src, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != separator {
+		return "", "", ErrNoFrontMatter
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == separator {
+			front := strings.Join(lines[1:i], "")
+			body := strings.Join(lines[i+1:], "")
+			return front, body, nil
+		}
+	}
+	return "", "", ErrNoFrontMatter
+}
+
+// ParseGroups reads the subset of YAML used for tag groups, which is a
+// set of top level keys each holding a list of strings.
+func ParseGroups(src string) (Groups, error) {
+	groups := Groups{}
+	group := ""
+	for i, line := range strings.Split(src, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "", strings.HasPrefix(trimmed, "#"):
+		case strings.HasPrefix(trimmed, "- "):
+			if group == "" {
+				return nil, fmt.Errorf("line %d: list item outside of a group", i+1)
+			}
+			groups[group] = append(groups[group], strings.TrimSpace(trimmed[2:]))
+		case strings.HasSuffix(trimmed, ":") && trimmed == line:
+			group = strings.TrimSuffix(trimmed, ":")
+			if _, ok := groups[group]; !ok {
+				groups[group] = []string{}
+			}
+		default:
+			return nil, fmt.Errorf("line %d: expected a group or list item but found %q", i+1, trimmed)
+		}
+	}
+	return groups, nil
+}
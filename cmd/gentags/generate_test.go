@@ -0,0 +1,78 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGenerate(t *testing.T) {
+
+	Convey(`The checked in generated files should match their templates, run 'go generate' when they don't`, t, func() {
+		generated := map[string]string{
+			"../../tags.go.tpl":          "../../tags_gen.go",
+			"../../atts.go.tpl":          "../../atts_gen.go",
+			"../../svg/svg.go.tpl":       "../../svg/svg_gen.go",
+			"../../mathml/mathml.go.tpl": "../../mathml/mathml_gen.go",
+		}
+		for tpl, gen := range generated {
+			expected, err := Generate(tpl)
+			So(err, ShouldBeNil)
+			actual, err := ioutil.ReadFile(gen)
+			So(err, ShouldBeNil)
+			So(string(actual), ShouldEqual, string(expected))
+		}
+	})
+
+	Convey(`Groups should be parsed in the order they are listed`, t, func() {
+		groups, err := ParseGroups("# tags\nnormal:\n  - Div\n  - Span\n\nvoid:\n  - Br\n")
+		So(err, ShouldBeNil)
+		So(groups, ShouldResemble, Groups{"normal": {"Div", "Span"}, "void": {"Br"}})
+	})
+
+	Convey(`Entries may give a name which differs from the identifier`, t, func() {
+		So(ident("HttpEquiv http-equiv"), ShouldEqual, "HttpEquiv")
+		So(name("HttpEquiv http-equiv"), ShouldEqual, "http-equiv")
+		So(name("Href"), ShouldEqual, "href")
+		So(camel("LinearGradient"), ShouldEqual, "linearGradient")
+		So(camel("G"), ShouldEqual, "g")
+		So(camel("Tspan"), ShouldEqual, "tspan")
+	})
+
+	Convey(`Malformed front matter should be an error`, t, func() {
+		_, err := ParseGroups("  - Div\n")
+		So(err, ShouldNotBeNil)
+		_, err = ParseGroups("normal: [Div]\n")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey(`Extra files should add to the groups of the template`, t, func() {
+		dir := t.TempDir()
+		tpl := filepath.Join(dir, "t.go.tpl")
+		extra := filepath.Join(dir, "extra.yaml")
+		err := ioutil.WriteFile(tpl, []byte(`---
+normal:
+  - Div
+---
+{{ .GEN_TAGLINE }}
+
+package x
+
+var names = []string{ {{ range .normal }}"{{ . | lower }}", {{ end }}{{ range .custom }}"{{ . }}", {{ end }} }
+`), 0644)
+		So(err, ShouldBeNil)
+		err = ioutil.WriteFile(extra, []byte("normal:\n  - Dialog\ncustom:\n  - my-el\n"), 0644)
+		So(err, ShouldBeNil)
+
+		src, err := Generate(tpl, extra)
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, `// Code generated by gentags from t.go.tpl; DO NOT EDIT.
+
+package x
+
+var names = []string{"div", "dialog", "my-el"}
+`)
+	})
+}
@@ -0,0 +1,61 @@
+// Command gentags generates Go source from a template whose YAML front
+// matter lists groups of tag names, like tags.go.tpl which produces
+// tags_gen.go.
+//
+// Usage:
+//
+//	gentags -in tags.go.tpl -out tags_gen.go [-extra more.yaml]
+//
+// The front matter sits between two "---" lines at the top of the template,
+// and each top level key names a group holding a list of tags:
+//
+//	---
+//	normal:
+//	  - Div
+//	void:
+//	  - Br
+//	---
+//
+// Every group is available to the template by name, along with GEN_TAGLINE
+// which holds the "Code generated" comment.  An entry may also be written
+// as an identifier followed by a name, "HttpEquiv http-equiv", which the
+// template splits with the ident and name funcs, or with camel for case
+// sensitive names.  Extra files hold front matter only and append their
+// tags to the groups of the template, or add new groups.  The output is
+// passed through gofmt.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+type files []string
+
+func (f *files) String() string {
+	return fmt.Sprint(*f)
+}
+
+func (f *files) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+func main() {
+	in := flag.String("in", "tags.go.tpl", "template with the tag groups as front matter")
+	out := flag.String("out", "tags_gen.go", "file to write the generated code to")
+	var extra files
+	flag.Var(&extra, "extra", "yaml file of additional tag groups, may be repeated")
+	flag.Parse()
+
+	src, err := Generate(*in, extra...)
+	if err == nil {
+		err = ioutil.WriteFile(*out, src, 0644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gentags:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/lcaballero/gel"
+)
+
+// Options controls the shape of the generated source.
+type Options struct {
+	Package string
+	Func    string
+	// Expr when true writes only the gel expression, without the package
+	// clause, imports or func.
+	Expr bool
+}
+
+// Convert parses the html read from r and returns formatted Go source that
+// builds the equivalent gel View.
+func Convert(r io.Reader, opts Options) ([]byte, error) {
+	v, err := gel.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer([]byte{})
+	if !opts.Expr {
+		buf.WriteString("package " + opts.Package + "\n\n")
+		buf.WriteString("import . \"github.com/lcaballero/gel\"\n\n")
+		buf.WriteString("func " + opts.Func + "() View {\n\treturn ")
+	}
+	g := &generator{buf: buf}
+	g.node(v.ToNode())
+	if opts.Expr {
+		return format.Source(buf.Bytes())
+	}
+	buf.WriteString("\n}\n")
+	return format.Source(buf.Bytes())
+}
+
+// generator writes the gel expression for a Node tree.
+type generator struct {
+	buf *bytes.Buffer
+}
+
+func (g *generator) node(n *gel.Node) {
+	switch n.Type {
+	case gel.Element:
+		g.element(n)
+	case gel.Textual:
+		g.call("Text", n.CData)
+	case gel.Markup:
+		g.call("Raw", n.CData)
+	case gel.Comment:
+		g.call("Cmt", n.CData)
+	case gel.NodeList:
+		g.buf.WriteString("Frag")
+		g.children(keep(n, n.Children))
+	}
+}
+
+// element writes the Tag for the element followed by its class, attributes
+// and boolean attributes, and then its children.
+func (g *generator) element(n *gel.Node) {
+	g.buf.WriteString(tagExpr(n))
+	var pairs, bools []string
+	for _, att := range n.Attributes {
+		switch {
+		case att.IsBool:
+			bools = append(bools, att.Key)
+		case att.Key == "class":
+			g.call(".Class", att.Value)
+		default:
+			pairs = append(pairs, att.Key, att.Value)
+		}
+	}
+	if len(pairs) > 0 {
+		g.call(".Atts", pairs...)
+	}
+	if len(bools) > 0 {
+		g.call(".Bool", bools...)
+	}
+	kids := keep(n, n.Children)
+	if len(kids) == 1 && kids[0].Type == gel.Textual {
+		g.call(".Text", kids[0].CData)
+		return
+	}
+	g.children(kids)
+}
+
+// children writes the call with each child View on its own line.
+func (g *generator) children(kids []*gel.Node) {
+	if len(kids) == 0 {
+		g.buf.WriteString("()")
+		return
+	}
+	g.buf.WriteString("(\n")
+	for _, kid := range kids {
+		g.node(kid)
+		g.buf.WriteString(",\n")
+	}
+	g.buf.WriteString(")")
+}
+
+// call writes a call to fn with the args as quoted strings.
+func (g *generator) call(fn string, args ...string) {
+	g.buf.WriteString(fn)
+	g.buf.WriteString("(")
+	for i, arg := range args {
+		if i > 0 {
+			g.buf.WriteString(", ")
+		}
+		g.buf.WriteString(quote(arg))
+	}
+	g.buf.WriteString(")")
+}
+
+// tagExpr returns the Go expression for the element's Tag, which is the
+// generated Tag var when there is one, and otherwise a call to E or El.
+func tagExpr(n *gel.Node) string {
+	if _, ok := gel.Tags[n.Tag]; ok {
+		return strings.ToUpper(n.Tag[:1]) + n.Tag[1:]
+	}
+	if n.IsVoid {
+		return "El(" + strconv.Quote(n.Tag) + ", true)"
+	}
+	return "E(" + strconv.Quote(n.Tag) + ")"
+}
+
+// keep drops the whitespace only text that is there to indent the html
+// source, which is whitespace with a block, or the edge of a block parent,
+// on either side.  Whitespace that separates inline content is kept as a
+// single space, and the content of pre, textarea and the raw text elements
+// is kept as is.
+func keep(parent *gel.Node, kids []*gel.Node) []*gel.Node {
+	if parent.Type == gel.Element && (layout.Layout(parent) == gel.Preformatted || gel.RawTextKind(parent.Tag) != gel.NormalText) {
+		return kids
+	}
+	res := make([]*gel.Node, 0, len(kids))
+	for i, kid := range kids {
+		if kid.Type != gel.Textual || strings.TrimSpace(kid.CData) != "" {
+			res = append(res, kid)
+			continue
+		}
+		if isBoundary(sibling(kids, i, -1), parent) && isBoundary(sibling(kids, i, 1), parent) {
+			continue
+		}
+		res = append(res, gel.Text(" ").ToNode())
+	}
+	return res
+}
+
+// layout decides which elements are blocks, the whitespace around which
+// only indents the source.
+var layout = gel.NewPretty()
+
+// sibling returns the nearest node before (dir -1) or after (dir 1) the
+// node at i, passing over comments and the doctype, or nil when there is
+// none.
+func sibling(kids []*gel.Node, i, dir int) *gel.Node {
+	for i += dir; i >= 0 && i < len(kids); i += dir {
+		kid := kids[i]
+		if kid.Type != gel.Comment && !(kid.Type == gel.Markup && strings.HasPrefix(kid.CData, "<!")) {
+			return kid
+		}
+	}
+	return nil
+}
+
+// isBoundary reports whether whitespace next to the sibling only indents
+// the source, since the sibling is a block, or there is no sibling and the
+// parent is a block or the top of the document.
+func isBoundary(sibling, parent *gel.Node) bool {
+	if sibling == nil {
+		return parent.Type != gel.Element || layout.Layout(parent) != gel.Inline
+	}
+	return sibling.Type == gel.Element && layout.Layout(sibling) != gel.Inline
+}
+
+// quote uses a raw string literal for multi-line text when possible since
+// it reads closer to the original html.
+func quote(s string) string {
+	if strings.Contains(s, "\n") && !strings.Contains(s, "`") {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lcaballero/gel"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConvert(t *testing.T) {
+
+	Convey(`Known tags should use the generated Tag vars with Class and Atts`, t, func() {
+		src, err := Convert(strings.NewReader(`<div class="card" id="c1" data-x="1"><p>Hello <b>there</b></p></div>`), Options{Expr: true})
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, `Div.Class("card").Atts("id", "c1", "data-x", "1")(
+	P(
+		Text("Hello "),
+		B.Text("there"),
+	),
+)`)
+	})
+
+	Convey(`Unknown tags should fall back to E and void tags render without children`, t, func() {
+		src, err := Convert(strings.NewReader("<ul>\n  <li><my-widget></my-widget><br></li>\n</ul>"), Options{Expr: true})
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, `Ul(
+	Li(
+		E("my-widget")(),
+		Br(),
+	),
+)`)
+	})
+
+	Convey(`Whitespace between inline elements should be kept as a space`, t, func() {
+		src, err := Convert(strings.NewReader("<div>\n  <p><b>bold</b>\n<i>italic</i></p>\n</div>"), Options{Expr: true})
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, `Div(
+	P(
+		B.Text("bold"),
+		Text(" "),
+		I.Text("italic"),
+	),
+)`)
+	})
+
+	Convey(`Whitespace in pre and textarea should be kept as is`, t, func() {
+		src, err := Convert(strings.NewReader("<div>\n<pre><b>a</b>\n  <i>b</i></pre>\n<textarea>\n</textarea>\n</div>"), Options{Expr: true})
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, "Div(\n\tPre(\n\t\tB.Text(\"a\"),\n\t\tText(`\n  `),\n\t\tI.Text(\"b\"),\n\t),\n\tText(\" \"),\n\tTextarea.Text(`\n`),\n\tText(\" \"),\n)")
+	})
+
+	Convey(`Multiple top level nodes should be wrapped in a Frag within a func`, t, func() {
+		src, err := Convert(strings.NewReader(`<!DOCTYPE html><html></html>`), Options{Package: "views", Func: "Page"})
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, `package views
+
+import . "github.com/lcaballero/gel"
+
+func Page() View {
+	return Frag(
+		Raw("<!DOCTYPE html>"),
+		Html(),
+	)
+}
+`)
+	})
+
+	Convey(`Valueless attributes should be written as boolean attributes`, t, func() {
+		src, err := Convert(strings.NewReader(`<input type="checkbox" checked disabled>`), Options{Expr: true})
+		So(err, ShouldBeNil)
+		So(string(src), ShouldEqual, `Input.Atts("type", "checkbox").Bool("checked", "disabled")()`)
+	})
+
+	Convey(`A void element which has no generated Tag should use El`, t, func() {
+		So(tagExpr(&gel.Node{Tag: "spacer", IsVoid: true}), ShouldEqual, `El("spacer", true)`)
+	})
+}
@@ -0,0 +1,49 @@
+// Command html2gel reads html and writes the Go source that builds the same
+// markup with gel, so existing templates can be ported by hand-editing the
+// result instead of typing it out.
+//
+// Usage:
+//
+//	html2gel [-pkg views] [-func Page] [-expr] [file.html]
+//
+// The html is read from the file, or stdin when no file is given, and the
+// Go source is written to stdout.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	pkg := flag.String("pkg", "views", "package name of the generated file")
+	fn := flag.String("func", "Page", "name of the generated func returning the View")
+	expr := flag.Bool("expr", false, "only write the gel expression")
+	flag.Parse()
+
+	err := run(os.Stdout, flag.Arg(0), Options{Package: *pkg, Func: *fn, Expr: *expr})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "html2gel:", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, file string, opts Options) error {
+	in := os.Stdin
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	src, err := Convert(in, opts)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
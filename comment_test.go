@@ -0,0 +1,45 @@
+package gel
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestComment(t *testing.T) {
+
+	Convey(`A Comment should render its text between comment delimiters`, t, func() {
+		s := Div(Cmt(" esi:include src=/nav "), Text("a")).ToNode().String()
+		So(s, ShouldEqual, `<div><!-- esi:include src=/nav -->a</div>`)
+		So(Cmt("x").ToNode().Type, ShouldEqual, Comment)
+	})
+
+	Convey(`Comment text should not be able to end the comment`, t, func() {
+		So(Cmt("a --> <script>").ToNode().String(), ShouldEqual, `<!--a - -> <script>-->`)
+		So(Cmt("--->").ToNode().String(), ShouldEqual, `<!--- - ->-->`)
+		So(Cmt(">x-").ToNode().String(), ShouldEqual, `<!-- >x- -->`)
+		So(SanitizeComment("a<!--b"), ShouldEqual, "a<!- -b")
+	})
+
+	Convey(`A conditional comment should enclose its children`, t, func() {
+		s := Head(CondCmt("lt IE 9", Script.Src("/html5shiv.js")())).ToNode().String()
+		So(s, ShouldEqual, `<head><!--[if lt IE 9]><script src="/html5shiv.js"></script><![endif]--></head>`)
+	})
+
+	Convey(`Comments should be indented like text`, t, func() {
+		buf := bytes.NewBuffer([]byte{})
+		Div(Cmt("note"), CondCmt("IE", P())).ToNode().WriteToIndented(NewIndent(), buf)
+		So(buf.String(), ShouldEqual, "<div>\n  <!--note-->\n  <!--[if IE]>\n    <p></p>\n  <![endif]-->\n</div>")
+	})
+
+	Convey(`Parsing should produce Comment nodes`, t, func() {
+		v, err := ParseString(`<p><!-- a --><!--[if IE]><b>x</b><![endif]--></p>`)
+		So(err, ShouldBeNil)
+		n := v.ToNode()
+		So(n.Children[0].Type, ShouldEqual, Comment)
+		So(n.Children[0].CData, ShouldEqual, " a ")
+		So(n.Children[1].Type, ShouldEqual, Markup)
+		So(n.String(), ShouldEqual, `<p><!-- a --><!--[if IE]><b>x</b><![endif]--></p>`)
+	})
+}
@@ -0,0 +1,118 @@
+package gel
+
+import (
+	"context"
+	"io"
+)
+
+// ViewFunc is a View built while rendering from the context given to
+// RenderContext, so that components deep in a tree can read request scoped
+// values, like the current user or a CSP nonce, without every component
+// above them passing the values along.  Attributes returned by a ViewFunc
+// are added to the enclosing element.
+//
+// ViewFuncs are resolved as they are rendered, so a Document doesn't hoist
+// views to its head from within them.
+type ViewFunc func(ctx context.Context) View
+
+// ToNode implements the View interface, producing a Contextual Node which
+// calls the func when rendered.
+func (f ViewFunc) ToNode() *Node {
+	node := &Node{
+		Type:    Contextual,
+		Resolve: f,
+	}
+	return node
+}
+
+// RenderContext writes the View to w like Render, resolving any ViewFuncs
+// with ctx.  Rendering stops once ctx is done, returning its error.
+func (r Renderer) RenderContext(ctx context.Context, w io.Writer, v View) (int64, error) {
+	p := &printer{Renderer: r, out: newWriter(w), ctx: ctx, done: ctx.Done(), views: map[*Node]*Node{}}
+	p.render(v.ToNode())
+	n, err := p.out.close()
+	if err == nil {
+		err = p.err
+	}
+	return n, err
+}
+
+// canceled reports whether rendering has been stopped, failing the writer
+// with the error of the context when it is done.
+func (p *printer) canceled() bool {
+	select {
+	case <-p.done:
+		p.out.fail(p.ctx.Err())
+		return true
+	default:
+		return p.out.failed()
+	}
+}
+
+// resolve calls the func of a Contextual Node with the context.  The node
+// it resolves to is kept, so the func is called once per render however
+// often a Formatter lays out the tree around it.
+func (p *printer) resolve(e *Node) *Node {
+	if node, ok := p.views[e]; ok {
+		return node
+	}
+	node := None().ToNode()
+	if e.Resolve != nil {
+		if v := e.Resolve(p.ctx); v != nil {
+			node = v.ToNode()
+		}
+	}
+	p.views[e] = node
+	return node
+}
+
+// resolved returns the nodes with the Contextual ones replaced by the
+// nodes they resolve to.
+func (p *printer) resolved(kids []*Node) []*Node {
+	for hasContextual(kids) {
+		list := Frag().ToNode()
+		for _, kid := range kids {
+			if kid.Type == Contextual {
+				list.Add(p.resolve(kid))
+			} else {
+				list.Children = append(list.Children, kid)
+			}
+		}
+		kids = list.Children
+	}
+	return kids
+}
+
+// withContext returns the element with its Contextual children resolved,
+// adding any attributes they resolve to.  The element itself is left
+// unchanged so that it can be rendered again with another context.
+func (p *printer) withContext(e *Node) *Node {
+	if !hasContextual(e.Children) {
+		return e
+	}
+	c := *e
+	c.Attributes = append([]*Node(nil), e.Attributes...)
+	c.Children = make([]*Node, 0, len(e.Children))
+	kids := e.Children
+	for hasContextual(kids) {
+		for _, kid := range kids {
+			if kid.Type == Contextual {
+				c.Add(p.resolve(kid))
+			} else {
+				c.Children = append(c.Children, kid)
+			}
+		}
+		kids, c.Children = c.Children, make([]*Node, 0, len(c.Children))
+	}
+	c.Children = kids
+	return &c
+}
+
+func hasContextual(kids []*Node) bool {
+	for _, kid := range kids {
+		if kid.Type == Contextual {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package gel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type userKey struct{}
+
+// greeting reads the user from the context of the render.
+func greeting() View {
+	return ViewFunc(func(ctx context.Context) View {
+		name, ok := ctx.Value(userKey{}).(string)
+		if !ok {
+			return Text("Hello, guest")
+		}
+		return Frag(Text("Hello, "), B.Text(name))
+	})
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), userKey{}, "Ada")
+
+	Convey(`ViewFuncs should be resolved with the context of the render`, t, func() {
+		page := Div(Header(P(greeting())))
+		buf := bytes.NewBuffer([]byte{})
+		_, err := Renderer{}.RenderContext(ctx, buf, page)
+		So(err, ShouldBeNil)
+		So(buf.String(), ShouldEqual, `<div><header><p>Hello, <b>Ada</b></p></header></div>`)
+
+		So(page.ToNode().String(), ShouldEqual, `<div><header><p>Hello, guest</p></header></div>`)
+	})
+
+	Convey(`Attributes from a ViewFunc should be added to the enclosing element`, t, func() {
+		nonce := ViewFunc(func(ctx context.Context) View {
+			return Att("nonce", ctx.Value(userKey{}).(string))
+		})
+		script := Script(Att("src", "/app.js"), nonce)
+		buf := bytes.NewBuffer([]byte{})
+		Renderer{}.RenderContext(ctx, buf, script)
+		So(buf.String(), ShouldEqual, `<script src="/app.js" nonce="Ada"></script>`)
+		So(len(script.ToNode().Attributes), ShouldEqual, 1)
+	})
+
+	Convey(`ViewFuncs in head assets should only be resolved when rendering`, t, func() {
+		calls := 0
+		nonce := ViewFunc(func(ctx context.Context) View {
+			calls++
+			return Att("nonce", ctx.Value(userKey{}).(string))
+		})
+		asset := ToHead(Script(Att("src", "/app.js"), nonce))
+		doc := &Document{Body: []View{asset, P.Text("x"), asset}}
+		buf := bytes.NewBuffer([]byte{})
+		_, err := Renderer{}.RenderContext(ctx, buf, doc)
+		So(err, ShouldBeNil)
+		So(calls, ShouldEqual, 1)
+		So(buf.String(), ShouldContainSubstring, `<head><script src="/app.js" nonce="Ada"></script></head>`)
+	})
+
+	Convey(`ViewFuncs should be resolved by Formatters and when minifying`, t, func() {
+		page := Ul(Li(greeting()), ViewFunc(func(ctx context.Context) View { return Li.Text("b") }))
+		So(render(Renderer{Format: NewPretty()}, page), ShouldEqual, "<ul>\n  <li>Hello, guest</li>\n  <li>b</li>\n</ul>")
+		So(render(Renderer{Minify: true}, page), ShouldEqual, "<ul><li>Hello, guest<li>b</ul>")
+	})
+
+	Convey(`Rendering should stop once the context is canceled`, t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		pulled := 0
+		rows := Stream(func() View {
+			pulled++
+			if pulled == 3 {
+				cancel()
+			}
+			return P.Fmt("%d", pulled)
+		})
+		buf := bytes.NewBuffer([]byte{})
+		_, err := Renderer{}.RenderContext(ctx, buf, Div(rows))
+		So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		So(pulled, ShouldEqual, 3)
+		So(buf.String(), ShouldEqual, `<div><p>1</p><p>2</p>`)
+	})
+
+	Convey(`ViewFuncs should be resolved once per render by a Formatter`, t, func() {
+		calls := 0
+		vf := ViewFunc(func(ctx context.Context) View {
+			calls++
+			return Text("a long run of text which won't fit on the line along with the tags around it")
+		})
+		for _, v := range []View{Div(Span(vf)), P(Span(vf)), Div(P(Span(vf)))} {
+			calls = 0
+			s := render(Renderer{Format: NewPretty()}, v)
+			So(s, ShouldContainSubstring, "a long run of text")
+			So(calls, ShouldEqual, 1)
+		}
+	})
+}
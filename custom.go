@@ -0,0 +1,236 @@
+package gel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrCustomElementName is returned when defining a custom element whose
+// name isn't a valid custom element name.
+var ErrCustomElementName = errors.New("custom element names must start with a lower case letter and contain a hyphen")
+
+// reservedNames are the hyphenated names of svg and MathML elements, which
+// can't be used for custom elements.
+var reservedNames = map[string]bool{
+	"annotation-xml":   true,
+	"color-profile":    true,
+	"font-face":        true,
+	"font-face-src":    true,
+	"font-face-uri":    true,
+	"font-face-format": true,
+	"font-face-name":   true,
+	"missing-glyph":    true,
+}
+
+// globalAttributes can be used on any custom element without being
+// declared, along with data-*, aria-* and on* event handlers.
+var globalAttributes = map[string]bool{
+	"accesskey":       true,
+	"autocapitalize":  true,
+	"autofocus":       true,
+	"class":           true,
+	"contenteditable": true,
+	"dir":             true,
+	"draggable":       true,
+	"enterkeyhint":    true,
+	"exportparts":     true,
+	"hidden":          true,
+	"id":              true,
+	"inert":           true,
+	"inputmode":       true,
+	"is":              true,
+	"lang":            true,
+	"nonce":           true,
+	"part":            true,
+	"popover":         true,
+	"role":            true,
+	"slot":            true,
+	"spellcheck":      true,
+	"style":           true,
+	"tabindex":        true,
+	"title":           true,
+	"translate":       true,
+}
+
+// CustomElement declares an autonomous custom element: its name, the
+// attributes it accepts beyond the global ones, those which are required,
+// and the names of the slots its children can be assigned to with a slot
+// attribute.  When Shadow is given it is rendered at the start of every
+// instance as a declarative shadow root, <template shadowrootmode="open">,
+// whose <slot name="..."> elements place the assigned children.
+type CustomElement struct {
+	Name       string
+	Attributes []string
+	Required   []string
+	Slots      []string
+	Shadow     View
+}
+
+// definitions holds the custom elements which have been defined by name,
+// and their count, which lets rendering skip the lookup until there are
+// any.
+var definitions = struct {
+	sync.RWMutex
+	byName map[string]*CustomElement
+	count  int32
+}{byName: map[string]*CustomElement{}}
+
+// ValidName returns ErrCustomElementName when name can't be used as the
+// name of a custom element.
+func ValidName(name string) error {
+	if name == "" || name[0] < 'a' || name[0] > 'z' || !strings.Contains(name, "-") || reservedNames[name] {
+		return fmt.Errorf("%q: %w", name, ErrCustomElementName)
+	}
+	for _, r := range name {
+		switch {
+		case 'A' <= r && r <= 'Z', r <= ' ', r == '/', r == '>', r == '=', r == '"', r == '\'':
+			return fmt.Errorf("%q: %w", name, ErrCustomElementName)
+		}
+	}
+	return nil
+}
+
+// Define checks the declaration of a custom element and returns the Tag
+// which builds it.  The definition is registered, and the name can only be
+// defined once.  Each instance of the element is checked when it is
+// rendered, and Render returns the error of the first one which is used
+// incorrectly once it has written the whole View, so misuse never drops
+// content.  Validate makes the same checks before rendering.
+func Define(def CustomElement) (Tag, error) {
+	if err := ValidName(def.Name); err != nil {
+		return nil, err
+	}
+	for _, key := range def.Required {
+		if !def.declares(key) {
+			return nil, fmt.Errorf("%s: required attribute %q isn't declared", def.Name, key)
+		}
+	}
+	if def.Shadow != nil {
+		for _, slot := range slotNames(def.Shadow.ToNode(), nil) {
+			if !contains(def.Slots, slot) {
+				return nil, fmt.Errorf("%s: shadow slot %q isn't declared", def.Name, slot)
+			}
+		}
+	}
+
+	definitions.Lock()
+	defer definitions.Unlock()
+	if _, ok := definitions.byName[def.Name]; ok {
+		return nil, fmt.Errorf("%s: custom element is already defined", def.Name)
+	}
+	definitions.byName[def.Name] = &def
+	atomic.AddInt32(&definitions.count, 1)
+
+	tag := E(def.Name)
+	if def.Shadow == nil {
+		return tag, nil
+	}
+	return tag.With(Template.Atts("shadowrootmode", "open")(def.Shadow)), nil
+}
+
+// MustDefine is like Define but panics when the declaration is invalid,
+// for defining elements in package level variables.
+func MustDefine(def CustomElement) Tag {
+	tag, err := Define(def)
+	if err != nil {
+		panic(err)
+	}
+	return tag
+}
+
+// Validate checks every defined custom element in the View, returning an
+// error for the first which is missing a required attribute, has an
+// attribute that isn't declared, or has a child assigned to a slot that
+// isn't declared.
+func Validate(v View) error {
+	return validate(v.ToNode())
+}
+
+func validate(e *Node) error {
+	if err := checkUsage(e); err != nil {
+		return err
+	}
+	for _, kid := range e.Children {
+		if err := validate(kid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkUsage checks the element when it is a defined custom element.
+func checkUsage(e *Node) error {
+	if e.Type != Element || atomic.LoadInt32(&definitions.count) == 0 {
+		return nil
+	}
+	definitions.RLock()
+	def := definitions.byName[e.Tag]
+	definitions.RUnlock()
+	if def == nil {
+		return nil
+	}
+	return def.check(e)
+}
+
+// check validates a single instance of the custom element.
+func (def *CustomElement) check(e *Node) error {
+	for _, key := range def.Required {
+		if e.attribute(key) == nil {
+			return fmt.Errorf("%s: missing required attribute %q", def.Name, key)
+		}
+	}
+	for _, att := range e.Attributes {
+		if !def.declares(att.Key) && !isGlobalAttribute(att.Key) {
+			return fmt.Errorf("%s: attribute %q isn't declared", def.Name, att.Key)
+		}
+	}
+	for _, kid := range e.Children {
+		if kid.Type != Element {
+			continue
+		}
+		if slot := kid.attribute("slot"); slot != nil && !contains(def.Slots, slot.Value) {
+			return fmt.Errorf("%s: slot %q isn't declared", def.Name, slot.Value)
+		}
+	}
+	return nil
+}
+
+// declares reports whether the attribute is declared by the element.
+func (def *CustomElement) declares(key string) bool {
+	return contains(def.Attributes, key)
+}
+
+// attribute returns the Element's Attribute with the given key.
+func (e *Node) attribute(key string) *Node {
+	for _, att := range e.Attributes {
+		if att.Key == key {
+			return att
+		}
+	}
+	return nil
+}
+
+// isGlobalAttribute reports whether the attribute can be used on any
+// element.
+func isGlobalAttribute(key string) bool {
+	return globalAttributes[key] ||
+		strings.HasPrefix(key, "data-") ||
+		strings.HasPrefix(key, "aria-") ||
+		strings.HasPrefix(key, "on")
+}
+
+// slotNames appends the names of the named slot elements in the tree.
+func slotNames(e *Node, names []string) []string {
+	if e.Type == Element && e.Tag == "slot" {
+		if name := e.attribute("name"); name != nil {
+			names = append(names, name.Value)
+		}
+	}
+	for _, kid := range e.Children {
+		names = slotNames(kid, names)
+	}
+	return names
+}
@@ -0,0 +1,78 @@
+package gel
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var userCard = MustDefine(CustomElement{
+	Name:       "user-card",
+	Attributes: []string{"name", "avatar"},
+	Required:   []string{"name"},
+	Slots:      []string{"bio"},
+	Shadow:     Div.Class("card")(Slot(Att("name", "bio"))),
+})
+
+func TestCustomElement(t *testing.T) {
+
+	Convey(`Custom element names should require a hyphen and a lower case start`, t, func() {
+		So(ValidName("my-widget"), ShouldBeNil)
+		So(ValidName("x-"), ShouldBeNil)
+		for _, name := range []string{"widget", "My-widget", "-widget", "my-Widget", "font-face", ""} {
+			So(errors.Is(ValidName(name), ErrCustomElementName), ShouldBeTrue)
+		}
+		_, err := Define(CustomElement{Name: "card"})
+		So(errors.Is(err, ErrCustomElementName), ShouldBeTrue)
+	})
+
+	Convey(`Definitions should be checked`, t, func() {
+		_, err := Define(CustomElement{Name: "a-b", Required: []string{"x"}})
+		So(err, ShouldNotBeNil)
+		_, err = Define(CustomElement{Name: "a-c", Shadow: Slot(Att("name", "x"))})
+		So(err, ShouldNotBeNil)
+		_, err = Define(CustomElement{Name: "user-card"})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey(`The Tag should render the shadow root before the children`, t, func() {
+		s := userCard(Att("name", "Ada"), P.Class("x")(Att("slot", "bio"), Text("hi"))).ToNode().String()
+		So(s, ShouldEqual, `<user-card name="Ada"><template shadowrootmode="open">`+
+			`<div class="card"><slot name="bio"></slot></div></template>`+
+			`<p class="x" slot="bio">hi</p></user-card>`)
+	})
+
+	Convey(`Validate should accept correct usage`, t, func() {
+		v := Div(userCard(Att("name", "Ada"), Att("class", "wide"), Att("data-id", "1"), P(Att("slot", "bio"))))
+		So(Validate(v), ShouldBeNil)
+	})
+
+	Convey(`Validate should report misuse of defined elements`, t, func() {
+		So(Validate(Div(userCard())), ShouldNotBeNil)
+		So(Validate(userCard(Att("name", "Ada"), Att("size", "2"))), ShouldNotBeNil)
+		So(Validate(userCard(Att("name", "Ada"), Span(Att("slot", "footer")))), ShouldNotBeNil)
+	})
+
+	Convey(`Rendering should report misuse of defined elements`, t, func() {
+		var sb strings.Builder
+		_, err := Renderer{}.Render(&sb, Div(userCard()))
+		So(err, ShouldNotBeNil)
+		_, err = Renderer{Format: NewPretty()}.Render(&sb, Div(Span(userCard(Att("size", "2")))))
+		So(err, ShouldNotBeNil)
+		_, err = Renderer{}.Render(&sb, Div(userCard(Att("name", "Ada"))))
+		So(err, ShouldBeNil)
+	})
+
+	Convey(`Misused elements should not drop the content around them`, t, func() {
+		s := Div(P.Text("before"), userCard(), P.Text("after")).ToNode().String()
+		So(s, ShouldStartWith, `<div><p>before</p><user-card>`)
+		So(s, ShouldEndWith, `</user-card><p>after</p></div>`)
+
+		var sb strings.Builder
+		_, err := Renderer{}.Render(&sb, Div(P.Text("before"), userCard(), P.Text("after")))
+		So(err, ShouldNotBeNil)
+		So(sb.String(), ShouldEqual, s)
+	})
+}
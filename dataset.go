@@ -0,0 +1,145 @@
+package gel
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DataAtts creates data-* attributes from a map with string keys or from
+// a struct.  See PrefixAtts.
+func DataAtts(v interface{}) View {
+	return PrefixAtts("data-", v)
+}
+
+// AriaAtts creates aria-* attributes from a map with string keys or from
+// a struct.  See PrefixAtts.
+func AriaAtts(v interface{}) View {
+	return PrefixAtts("aria-", v)
+}
+
+// Data creates a tag with data-* attributes from a map or struct.
+func (t Tag) Data(v interface{}) Tag {
+	return t.With(DataAtts(v))
+}
+
+// Aria creates a tag with aria-* attributes from a map or struct.
+func (t Tag) Aria(v interface{}) Tag {
+	return t.With(AriaAtts(v))
+}
+
+// PrefixAtts creates an AttributeList with an attribute for each entry of a
+// map with string keys, in sorted key order, or for each exported field of a
+// struct, in field order.  Names are converted from camelCase to kebab-case
+// and given the prefix, unless a field has a `gel:"name"` tag, and fields
+// tagged `gel:"-"` are skipped.  Strings are used as is, bools become
+// "true" or "false", numbers are formatted with strconv, nil values are
+// omitted and anything else is encoded as json.
+func PrefixAtts(prefix string, v interface{}) View {
+	list := Atts().ToNode()
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return list
+		}
+		val = val.Elem()
+	}
+	switch val.Kind() {
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return list
+		}
+		keys := val.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+		for _, key := range keys {
+			addPrefixed(list, prefix+Kebab(key.String()), val.MapIndex(key))
+		}
+	case reflect.Struct:
+		typ := val.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := field.Tag.Get("gel")
+			switch name {
+			case "-":
+				continue
+			case "":
+				name = prefix + Kebab(field.Name)
+			}
+			addPrefixed(list, name, val.Field(i))
+		}
+	}
+	return list
+}
+
+// addPrefixed adds the attribute to the list unless the value is nil or
+// can't be serialized.
+func addPrefixed(list *Node, key string, val reflect.Value) {
+	value, ok := serialize(val)
+	if ok {
+		list.Add(Att(key, value))
+	}
+}
+
+// serialize converts the value of an attribute to a string.
+func serialize(val reflect.Value) (string, bool) {
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return "", false
+		}
+		val = val.Elem()
+	}
+	switch val.Kind() {
+	case reflect.String:
+		return val.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(val.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(val.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 64), true
+	case reflect.Invalid:
+		return "", false
+	}
+	b, err := json.Marshal(val.Interface())
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// Kebab converts a camelCase or PascalCase name to kebab-case, so that
+// "userID" becomes "user-id" and "HTMLParser" becomes "html-parser".
+// Underscores are also replaced by dashes.
+func Kebab(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		switch {
+		case r == '_':
+			b.WriteByte('-')
+		case unicode.IsUpper(r):
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			prevUpper := i > 0 && unicode.IsUpper(runes[i-1])
+			if prevLower || (prevUpper && nextLower) {
+				b.WriteByte('-')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDataAtts(t *testing.T) {
+
+	Convey(`Map entries should render as prefixed attributes in sorted order`, t, func() {
+		s := Div.Data(map[string]interface{}{
+			"controller": "search",
+			"maxResults": 20,
+			"live":       true,
+			"ratio":      0.5,
+			"missing":    nil,
+		}).Text("x").ToNode().String()
+		So(s, ShouldEqual, `<div data-controller="search" data-live="true" data-max-results="20" data-ratio="0.5">x</div>`)
+	})
+
+	Convey(`Struct fields should render in field order honoring gel tags`, t, func() {
+		type aria struct {
+			Expanded   bool
+			Controls   string
+			LabelledBy string `gel:"aria-labelledby"`
+			Skip       string `gel:"-"`
+			hidden     bool
+		}
+		s := Button.Aria(aria{Expanded: false, Controls: "menu", LabelledBy: "btn-label"})().ToNode().String()
+		So(s, ShouldEqual, `<button aria-expanded="false" aria-controls="menu" aria-labelledby="btn-label"></button>`)
+	})
+
+	Convey(`Values which aren't strings, bools or numbers should be encoded as json`, t, func() {
+		s := Div(DataAtts(map[string]interface{}{"items": []int{1, 2}, "opts": map[string]string{"a": "b"}})).ToNode().String()
+		So(s, ShouldEqual, `<div data-items="[1,2]" data-opts="{&#34;a&#34;:&#34;b&#34;}"></div>`)
+	})
+
+	Convey(`Non string keyed maps and nil values should produce no attributes`, t, func() {
+		So(AriaAtts(map[int]string{1: "a"}).ToNode().Children, ShouldBeEmpty)
+		So(AriaAtts(nil).ToNode().Children, ShouldBeEmpty)
+	})
+
+	Convey(`Kebab should convert camel case names`, t, func() {
+		So(Kebab("userID"), ShouldEqual, "user-id")
+		So(Kebab("HTMLParser"), ShouldEqual, "html-parser")
+		So(Kebab("hxPost"), ShouldEqual, "hx-post")
+		So(Kebab("already-kebab"), ShouldEqual, "already-kebab")
+		So(Kebab("snake_case"), ShouldEqual, "snake-case")
+		So(Kebab("item2Name"), ShouldEqual, "item2-name")
+	})
+}
@@ -0,0 +1,122 @@
+package gel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Doctype is the document type declaration which puts browsers in
+// standards mode.
+const Doctype = "<!DOCTYPE html>"
+
+// Document is a View of a complete html page: the doctype, the html
+// element with its lang, and a head and body built from the fields.
+// Attributes included in Body are added to the body element.
+//
+// Components rendered in the body can contribute to the head by returning
+// their stylesheets, scripts or meta tags wrapped with ToHead, which the
+// Document collects, removing duplicates, and places in its head.
+type Document struct {
+	Lang    string
+	Charset string
+	Title   string
+	Meta    []View
+	Links   []View
+	Head    []View
+	Scripts []View
+	Body    []View
+}
+
+// NewDocument creates an english, utf-8 Document with the given title
+// and body.
+func NewDocument(title string, body ...View) *Document {
+	return &Document{
+		Lang:    "en",
+		Charset: "utf-8",
+		Title:   title,
+		Body:    body,
+	}
+}
+
+// ToHead wraps views that belong in the head of the page, such as the
+// stylesheet a component depends on.  A Document moves them into its head,
+// and the views are not rendered where they are placed in the body.
+func ToHead(views ...View) View {
+	node := &Node{
+		Type:     Hoisted,
+		Children: make([]*Node, 0),
+	}
+	return node.Add(views...)
+}
+
+// ToNode implements the View interface, producing the doctype and html
+// element.  The body is built first so that its components can contribute
+// to the head.
+func (d *Document) ToNode() *Node {
+	body := Body(d.Body...).ToNode()
+
+	head := Head().ToNode()
+	if d.Charset != "" {
+		head.Add(Meta.Charset(d.Charset)())
+	}
+	if d.Title != "" {
+		head.Add(Title.Text(d.Title))
+	}
+	head.Add(d.Meta...)
+	head.Add(d.Links...)
+	head.Add(d.Head...)
+	seen := map[string]bool{}
+	for _, asset := range hoisted(body, nil) {
+		key := assetKey(asset)
+		if !seen[key] {
+			seen[key] = true
+			head.Add(asset)
+		}
+	}
+	head.Add(d.Scripts...)
+
+	html := Html().ToNode()
+	if d.Lang != "" {
+		html.Add(Att("lang", d.Lang))
+	}
+	html.Add(head, body)
+	return Frag(Raw(Doctype), html).ToNode()
+}
+
+// assetKey identifies an asset by its structure, so that duplicates can be
+// found without rendering it before the context of the render exists.
+// ViewFuncs and Streams are only the same as themselves.
+func assetKey(e *Node) string {
+	var sb strings.Builder
+	writeKey(&sb, e)
+	return sb.String()
+}
+
+func writeKey(sb *strings.Builder, e *Node) {
+	if e.Type == Contextual || e.Type == Streamed {
+		fmt.Fprintf(sb, "%d:%p;", e.Type, e)
+		return
+	}
+	fmt.Fprintf(sb, "%d:%q:%q:%q:%q:%q:%t:%t;", e.Type, e.Tag, e.Namespace, e.Key, e.Value, e.CData, e.IsBool, e.Trusted)
+	sb.WriteByte('[')
+	for _, att := range e.Attributes {
+		writeKey(sb, att)
+	}
+	sb.WriteByte('|')
+	for _, kid := range e.Children {
+		writeKey(sb, kid)
+	}
+	sb.WriteByte(']')
+}
+
+// hoisted appends the children of the Hoisted nodes found in the tree.
+func hoisted(e *Node, found []*Node) []*Node {
+	for _, kid := range e.Children {
+		if kid.Type == Hoisted {
+			found = append(found, kid.Children...)
+			continue
+		}
+		found = hoisted(kid, found)
+	}
+	return found
+}
@@ -0,0 +1,57 @@
+package gel
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// datePicker is a component which depends on a stylesheet and script.
+func datePicker(name string) View {
+	return Frag(
+		ToHead(
+			Link.Rel("stylesheet").Href("/css/picker.css")(),
+			Script.Src("/js/picker.js")(),
+		),
+		Input.Type("date").Name(name)(),
+	)
+}
+
+func TestDocument(t *testing.T) {
+
+	Convey(`A Document should render the doctype, head and body`, t, func() {
+		doc := NewDocument("Home & Away", P.Text("hello"))
+		doc.Meta = []View{Meta.Name("viewport").Content("width=device-width")()}
+		doc.Scripts = []View{Script.Src("/app.js")()}
+		So(doc.ToNode().String(), ShouldEqual, `<!DOCTYPE html><html lang="en"><head>`+
+			`<meta charset="utf-8"><title>Home &amp; Away</title>`+
+			`<meta name="viewport" content="width=device-width"><script src="/app.js"></script>`+
+			`</head><body><p>hello</p></body></html>`)
+	})
+
+	Convey(`Components in the body should contribute assets to the head once`, t, func() {
+		doc := &Document{
+			Links: []View{Link.Rel("stylesheet").Href("/css/site.css")()},
+			Body:  []View{Class("page"), Form(datePicker("from"), datePicker("to"))},
+		}
+		So(doc.ToNode().String(), ShouldEqual, `<!DOCTYPE html><html><head>`+
+			`<link rel="stylesheet" href="/css/site.css">`+
+			`<link rel="stylesheet" href="/css/picker.css"><script src="/js/picker.js"></script>`+
+			`</head><body class="page"><form>`+
+			`<input type="date" name="from"><input type="date" name="to">`+
+			`</form></body></html>`)
+	})
+
+	Convey(`A Document should render with indention`, t, func() {
+		buf := bytes.NewBuffer([]byte{})
+		_, err := NewDocument("t").ToNode().WriteToIndented(NewIndent(), buf)
+		So(err, ShouldBeNil)
+		So(buf.String(), ShouldStartWith, "<!DOCTYPE html>\n<html lang=\"en\">\n  <head>\n")
+	})
+
+	Convey(`Views wrapped with ToHead should not render outside of a Document`, t, func() {
+		So(Div(datePicker("d")).ToNode().String(), ShouldEqual, `<div><input type="date" name="d"></div>`)
+		So(ToHead(Meta()).ToNode().Type.String(), ShouldEqual, "Hoisted")
+	})
+}
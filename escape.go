@@ -0,0 +1,255 @@
+package gel
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// UnsafeURL replaces the value of a URL attribute whose scheme is not
+// known to be safe, e.g. javascript:alert(1) in an href.
+const UnsafeURL = "#ZgelZ"
+
+// textEntity returns the replacement for the characters that are special
+// in html text content.
+func textEntity(c byte) string {
+	switch c {
+	case '&':
+		return "&amp;"
+	case '<':
+		return "&lt;"
+	case '>':
+		return "&gt;"
+	}
+	return ""
+}
+
+// attrEntity returns the replacement for the characters that are special
+// in a double quoted attribute value.
+func attrEntity(c byte) string {
+	switch c {
+	case '"':
+		return "&#34;"
+	case '\'':
+		return "&#39;"
+	}
+	return textEntity(c)
+}
+
+// urlAttributes are the attributes whose values are interpreted as URLs.
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"archive":    true,
+	"background": true,
+	"cite":       true,
+	"classid":    true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"ping":       true,
+	"poster":     true,
+	"profile":    true,
+	"src":        true,
+	"usemap":     true,
+	"xlink:href": true,
+}
+
+// safeSchemes are the URL schemes allowed to appear in a URL attribute.
+var safeSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+	"tel":    true,
+	"ftp":    true,
+}
+
+// Escaping identifies the context a value is written into, and therefore
+// which escaping rules apply to it.
+type Escaping int
+
+// The escaping contexts.
+const (
+	// EscapeText is ordinary html text content.
+	EscapeText Escaping = iota
+	// EscapeAttr is a double quoted attribute value.
+	EscapeAttr
+	// EscapeURL is a double quoted attribute value holding a URL.
+	EscapeURL
+	// EscapeScript is the body of a <script> element.
+	EscapeScript
+	// EscapeStyle is the body of a <style> element.
+	EscapeStyle
+)
+
+// Escape makes s safe to write in the given context.
+func Escape(ctx Escaping, s string) string {
+	switch ctx {
+	case EscapeAttr:
+		return replace(s, attrEntity)
+	case EscapeURL:
+		return replace(FilterURL(s), attrEntity)
+	case EscapeScript:
+		return escapeEndTag(s, "script", `\x3C`)
+	case EscapeStyle:
+		return escapeEndTag(s, "style", `\3c `)
+	default:
+		return replace(s, textEntity)
+	}
+}
+
+// replace substitutes each byte of s for which entity returns a non-empty
+// replacement, returning s itself when nothing needs replacing.
+func replace(s string, entity func(byte) string) string {
+	for i := 0; i < len(s); i++ {
+		if entity(s[i]) != "" {
+			var b strings.Builder
+			w := &writer{dst: &b}
+			w.replace(s, entity)
+			return b.String()
+		}
+	}
+	return s
+}
+
+// TextKind classifies elements by the kind of content the html syntax
+// allows them to hold.
+type TextKind int
+
+// The kinds of element content.
+const (
+	// NormalText elements can hold text and other elements.
+	NormalText TextKind = iota
+	// RawText elements, script and style, hold text which isn't escaped
+	// and can't contain character references.
+	RawText
+	// EscapableRawText elements, textarea and title, hold only text, in
+	// which character references are decoded.
+	EscapableRawText
+)
+
+// RawTextKind returns the kind of content the named element holds.
+func RawTextKind(tag string) TextKind {
+	switch strings.ToLower(tag) {
+	case "script", "style":
+		return RawText
+	case "textarea", "title":
+		return EscapableRawText
+	}
+	return NormalText
+}
+
+// AttrEscaping returns the escaping context for the value of the given
+// attribute.
+func AttrEscaping(key string) Escaping {
+	if urlAttributes[strings.ToLower(key)] {
+		return EscapeURL
+	}
+	return EscapeAttr
+}
+
+// TextEscaping returns the escaping context for text written as the
+// child of the named element.
+func TextEscaping(tag string) Escaping {
+	switch strings.ToLower(tag) {
+	case "script":
+		return EscapeScript
+	case "style":
+		return EscapeStyle
+	default:
+		return EscapeText
+	}
+}
+
+// FilterURL returns the url unchanged if it is relative or uses one of
+// the safe schemes (http, https, mailto, tel, ftp), otherwise it returns
+// UnsafeURL.
+func FilterURL(url string) string {
+	trimmed := strings.TrimSpace(url)
+	colon := strings.IndexByte(trimmed, ':')
+	if colon < 0 {
+		return url
+	}
+	// A '/', '?' or '#' before the first ':' means the colon is not
+	// part of a scheme, e.g. "/a:b" or "?q=a:b".
+	if strings.IndexAny(trimmed[:colon], "/?#") >= 0 {
+		return url
+	}
+	if safeSchemes[strings.ToLower(trimmed[:colon])] {
+		return url
+	}
+	return UnsafeURL
+}
+
+// escapeEndTag keeps a raw text body from closing its element early, or
+// opening an html comment, by replacing the '<' of "</tag" and "<!--"
+// with lt, which is the escaped form of '<' in the body's language.
+func escapeEndTag(s, tag, lt string) string {
+	if !strings.Contains(s, "<") {
+		return s
+	}
+	end := "</" + tag
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != '<' {
+			continue
+		}
+		isEnd := len(s)-i >= len(end) && strings.EqualFold(s[i:i+len(end)], end)
+		if isEnd || strings.HasPrefix(s[i:], "<!--") {
+			b.WriteString(s[last:i])
+			b.WriteString(lt)
+			last = i + 1
+		}
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// SanitizeComment makes text safe to place within an html comment by
+// breaking up every "--", so the text can't end the comment or open a
+// nested one, and by spacing out a leading '>' or trailing '-' which would
+// otherwise join with the comment's own delimiters.
+func SanitizeComment(text string) string {
+	for strings.Contains(text, "--") {
+		text = strings.Replace(text, "--", "- -", -1)
+	}
+	if strings.HasPrefix(text, ">") || strings.HasPrefix(text, "->") {
+		text = " " + text
+	}
+	if strings.HasSuffix(text, "-") || strings.HasSuffix(text, "<!") {
+		text += " "
+	}
+	return text
+}
+
+// ValidXML drops the characters which can't appear in an xml document,
+// which are most control characters, invalid utf-8, and the non-characters
+// U+FFFE and U+FFFF.
+func ValidXML(s string) string {
+	for _, r := range s {
+		if !isXMLChar(r) {
+			return strings.Map(func(r rune) rune {
+				if isXMLChar(r) {
+					return r
+				}
+				return -1
+			}, s)
+		}
+	}
+	return s
+}
+
+func isXMLChar(r rune) bool {
+	switch {
+	case r == '\t' || r == '\n' || r == '\r':
+		return true
+	case r < 0x20, r == utf8.RuneError:
+		return false
+	case r <= 0xD7FF, 0xE000 <= r && r <= 0xFFFD, 0x10000 <= r && r <= 0x10FFFF:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEscape(t *testing.T) {
+
+	Convey(`Text should escape html special characters`, t, func() {
+		s := Div(Text(`<script>alert("x") & more</script>`)).ToNode().String()
+		So(s, ShouldEqual, `<div>&lt;script&gt;alert("x") &amp; more&lt;/script&gt;</div>`)
+	})
+
+	Convey(`Fmt should escape the formatted result`, t, func() {
+		s := P.Fmt("hello, %s", "<b>world</b>").ToNode().String()
+		So(s, ShouldEqual, `<p>hello, &lt;b&gt;world&lt;/b&gt;</p>`)
+	})
+
+	Convey(`Attribute values should escape quotes`, t, func() {
+		s := Div.Atts("title", `" onclick="alert(1)`)().ToNode().String()
+		So(s, ShouldEqual, `<div title="&#34; onclick=&#34;alert(1)"></div>`)
+	})
+
+	Convey(`URL attributes should replace unsafe schemes`, t, func() {
+		s := A.Atts("href", "javascript:alert(1)").Text("x").ToNode().String()
+		So(s, ShouldEqual, `<a href="#ZgelZ">x</a>`)
+
+		s = Img(Att("SRC", " JavaScript:alert(1)")).ToNode().String()
+		So(s, ShouldEqual, `<img SRC="#ZgelZ">`)
+	})
+
+	Convey(`URL attributes should keep safe and relative urls`, t, func() {
+		urls := []string{
+			"https://example.com/?a=1",
+			"/path/a:b",
+			"page.html?q=a:b",
+			"#frag",
+			"mailto:me@example.com",
+		}
+		for _, u := range urls {
+			So(FilterURL(u), ShouldEqual, u)
+		}
+		s := A.Atts("href", "/a?b=1&c=2")().ToNode().String()
+		So(s, ShouldEqual, `<a href="/a?b=1&amp;c=2"></a>`)
+	})
+
+	Convey(`Script bodies should not be html escaped but cannot close the element`, t, func() {
+		s := Script.Text(`if (a < b && c) { x = "</script><b>" }`).ToNode().String()
+		So(s, ShouldEqual, `<script>if (a < b && c) { x = "\x3C/script><b>" }</script>`)
+	})
+
+	Convey(`Style bodies should not be html escaped but cannot close the element`, t, func() {
+		s := Style.Text(`a > b { content: "</STYLE>" }`).ToNode().String()
+		So(s, ShouldEqual, `<style>a > b { content: "\3c /STYLE>" }</style>`)
+	})
+
+	Convey(`Trusted text and attributes should be written verbatim`, t, func() {
+		s := Div(
+			TrustedAtt("onclick", `go("x")`),
+			TrustedText("<b>bold</b>"),
+		).ToNode().String()
+		So(s, ShouldEqual, `<div onclick="go("x")"><b>bold</b></div>`)
+	})
+}
@@ -0,0 +1,332 @@
+package gel
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Layout is how a Formatter places an element.
+type Layout int
+
+// The layouts of elements.
+const (
+	// Inline elements flow with the text around them, so no whitespace is
+	// added or removed next to them, and lines are only broken at the
+	// whitespace already within their text.
+	Inline Layout = iota
+	// Block elements start on a line of their own, with their children
+	// indented on the following lines unless they fit on the same line.
+	Block
+	// Preformatted elements start on a line of their own like Block elements, but
+	// their content is written exactly as given, preserving whitespace.
+	Preformatted
+)
+
+// Formatter decides the layout of the html written by a Renderer.  Embed
+// Pretty in another type to override one of its decisions, such as to make
+// a custom element Block.
+type Formatter interface {
+	// Layout returns how the element is placed.
+	Layout(e *Node) Layout
+	// Indention returns the whitespace starting a line at the given depth.
+	Indention(depth int) string
+	// Width returns the length of line past which text is wrapped, or 0
+	// when lines aren't limited.
+	Width() int
+	// WrapAttributes reports whether the attributes of a Block start tag
+	// which doesn't fit on a line are written on lines of their own.
+	WrapAttributes() bool
+}
+
+// blockElements are the elements Pretty lays out as Block, the whitespace
+// around which doesn't change how a page is rendered.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "base": true,
+	"blockquote": true, "body": true, "caption": true, "col": true,
+	"colgroup": true, "dd": true, "details": true, "dialog": true,
+	"div": true, "dl": true, "dt": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"head": true, "header": true, "hgroup": true, "hr": true, "html": true,
+	"legend": true, "li": true, "link": true, "main": true, "menu": true,
+	"meta": true, "nav": true, "noscript": true, "ol": true,
+	"optgroup": true, "option": true, "p": true, "script": true,
+	"search": true, "section": true, "style": true, "summary": true,
+	"table": true, "tbody": true, "td": true, "template": true,
+	"tfoot": true, "th": true, "thead": true, "title": true, "tr": true,
+	"ul": true,
+}
+
+// Pretty is the Formatter which lays out the html elements as browsers
+// display them, treating unknown elements as Inline so that formatting
+// never changes the rendered whitespace.
+type Pretty struct {
+	Tab      string
+	MaxWidth int
+	Wrap     bool
+}
+
+// NewPretty returns a Pretty Formatter which indents with DefaultTab and
+// wraps lines and attributes at 80 characters.
+func NewPretty() Pretty {
+	return Pretty{Tab: DefaultTab, MaxWidth: 80, Wrap: true}
+}
+
+// Layout returns Preformatted for pre and listing, Block for the block elements of
+// html and Inline for every other element, including svg and MathML.
+func (f Pretty) Layout(e *Node) Layout {
+	switch {
+	case foreign(e):
+		return Inline
+	case e.Tag == "pre" || e.Tag == "listing":
+		return Preformatted
+	case blockElements[e.Tag]:
+		return Block
+	}
+	return Inline
+}
+
+// Indention repeats the Tab depth times.
+func (f Pretty) Indention(depth int) string {
+	return strings.Repeat(f.Tab, depth)
+}
+
+// Width returns the MaxWidth.
+func (f Pretty) Width() int {
+	return f.MaxWidth
+}
+
+// WrapAttributes returns Wrap.
+func (f Pretty) WrapAttributes() bool {
+	return f.Wrap
+}
+
+// format writes the node at the given depth using the Formatter.
+func (p *printer) format(e *Node, depth int, esc Escaping) {
+	switch e.Type {
+	case Attribute, AttributeList:
+		p.node(e, Indent{}, esc)
+	case NodeList:
+		p.flow(e.Children, depth, esc)
+	default:
+		p.flow([]*Node{e}, depth, esc)
+	}
+}
+
+// flow lays out sibling nodes at the given depth, writing each block on
+// its own line and filling lines with each run of inline nodes between
+// them.
+func (p *printer) flow(kids []*Node, depth int, esc Escaping) {
+	kids = p.resolved(kids)
+	start := 0
+	for i, kid := range kids {
+		switch {
+		case kid.Type == Element && p.Format.Layout(kid) != Inline:
+			p.run(kids[start:i], depth, esc)
+			p.block(kid, depth)
+			start = i + 1
+		case kid.Type == Streamed:
+			p.run(kids[start:i], depth, esc)
+			p.stream(kid, func(v *Node) {
+				p.format(v, depth, esc)
+			})
+			start = i + 1
+		}
+	}
+	p.run(kids[start:], depth, esc)
+}
+
+// block writes a Block or Preformatted element starting on a new line.
+func (p *printer) block(e *Node, depth int) {
+	if p.canceled() {
+		return
+	}
+	e = p.withContext(e)
+	p.line(depth)
+	restore, col := p.openTag(e, depth)
+	defer restore()
+	if !p.closeStartTag(e) {
+		return
+	}
+	if p.Format.Layout(e) == Preformatted || p.preserves(e) {
+		p.content(e, Indent{})
+		p.endTag(e)
+		return
+	}
+	esc := p.escaping(e)
+	if col > 0 && !p.hasBlocks(e.Children) && !p.hasStream(e.Children) {
+		compact := p.capture(func() {
+			for _, kid := range e.Children {
+				p.node(kid, Indent{}, esc)
+			}
+		})
+		if !strings.Contains(compact, "\n") && p.fits(depth, col+utf8.RuneCountInString(compact)+len(e.Tag)+3) {
+			p.out.str(compact)
+			p.endTag(e)
+			return
+		}
+	}
+	p.flow(e.Children, depth+1, esc)
+	p.line(depth)
+	p.endTag(e)
+}
+
+// openTag writes the start tag of the element, placing each attribute on
+// its own line when the tag doesn't fit within the width.  It returns the
+// func restoring the namespace scope and the length of the tag with its
+// closing '>', which is 0 once the attributes are wrapped.
+func (p *printer) openTag(e *Node, depth int) (func(), int) {
+	tag := p.capture(func() { p.startTag(e)() })
+	col := utf8.RuneCountInString(tag) + 1
+	if p.Format.WrapAttributes() && len(e.Attributes) > 1 && !p.fits(depth, col) {
+		p.wrap = "\n" + p.Format.Indention(depth+1)
+		defer func() { p.wrap = "" }()
+		col = 0
+	}
+	return p.startTag(e), col
+}
+
+// preserves reports whether the content of the element is raw text,
+// which is written exactly as given.
+func (p *printer) preserves(e *Node) bool {
+	return p.Mode != XML && !foreign(e) && RawTextKind(e.Tag) != NormalText
+}
+
+// hasBlocks reports whether any of the nodes are Block or Preformatted
+// elements, or Streams, which are laid out as they are pulled.
+func (p *printer) hasBlocks(kids []*Node) bool {
+	for _, kid := range kids {
+		if kid.Type == Streamed || (kid.Type == Element && p.Format.Layout(kid) != Inline) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasStream reports whether a Stream is among the nodes or their
+// descendants, including those of ViewFuncs, which can't be rendered on
+// trial since it is drained as it is pulled.
+func (p *printer) hasStream(kids []*Node) bool {
+	for _, kid := range p.resolved(kids) {
+		if kid.Type == Streamed || p.hasStream(kid.Children) {
+			return true
+		}
+	}
+	return false
+}
+
+// fits reports whether n more characters fit on the line started at the
+// given depth.
+func (p *printer) fits(depth, n int) bool {
+	w := p.Format.Width()
+	return w <= 0 || utf8.RuneCountInString(p.Format.Indention(depth))+n <= w
+}
+
+// line starts a new line at the given depth.
+func (p *printer) line(depth int) {
+	if p.lines > 0 {
+		p.out.byte('\n')
+	}
+	p.lines++
+	p.out.str(p.Format.Indention(depth))
+}
+
+// capture returns what f writes rather than writing it.
+func (p *printer) capture(f func()) string {
+	var sb strings.Builder
+	out := p.out
+	p.out = newWriter(&sb)
+	f()
+	p.out.close()
+	p.out = out
+	return sb.String()
+}
+
+// run fills lines at the given depth with a run of inline nodes, breaking
+// lines only at the whitespace within their text.  Since browsers collapse
+// that whitespace, each break is written as a single space or newline.
+func (p *printer) run(kids []*Node, depth int, esc Escaping) {
+	var w words
+	for _, kid := range kids {
+		p.words(&w, kid, esc)
+	}
+	w.flush()
+	line := ""
+	for _, word := range w.list {
+		switch {
+		case line == "":
+			line = word
+		case p.fits(depth, utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word)):
+			line += " " + word
+		default:
+			p.line(depth)
+			p.out.str(line)
+			line = word
+		}
+	}
+	if line != "" {
+		p.line(depth)
+		p.out.str(line)
+	}
+}
+
+// words splits an inline node into the words of its text, where the tags
+// of inline elements are part of the words they touch.
+func (p *printer) words(w *words, e *Node, esc Escaping) {
+	switch {
+	case e.Type == Textual && !e.Trusted:
+		w.text(p.capture(func() { p.text(e, esc, e.CData) }))
+	case e.Type == Contextual:
+		p.words(w, p.resolve(e), esc)
+	case e.Type == NodeList:
+		for _, kid := range e.Children {
+			p.words(w, kid, esc)
+		}
+	case e.Type == Element && !p.preserves(e) && !p.hasBlocks(p.resolved(e.Children)):
+		e = p.withContext(e)
+		var restore func()
+		w.atom(p.capture(func() { restore = p.startTag(e) }))
+		open := false
+		w.atom(p.capture(func() { open = p.closeStartTag(e) }))
+		if open {
+			for _, kid := range e.Children {
+				p.words(w, kid, p.escaping(e))
+			}
+			w.atom(p.capture(func() { p.endTag(e) }))
+		}
+		restore()
+	case e.Type == Hoisted:
+	default:
+		w.atom(p.capture(func() { p.node(e, Indent{}, esc) }))
+	}
+}
+
+// words collects the words of a run of inline nodes.
+type words struct {
+	list []string
+	cur  strings.Builder
+}
+
+// text adds text, which is split into words at whitespace.
+func (w *words) text(s string) {
+	for i := 0; i < len(s); i++ {
+		if isSpace(s[i]) {
+			w.flush()
+			continue
+		}
+		w.cur.WriteByte(s[i])
+	}
+}
+
+// atom adds markup which can't be split, joining the word it touches.
+func (w *words) atom(s string) {
+	w.cur.WriteString(s)
+}
+
+// flush ends the current word.
+func (w *words) flush() {
+	if w.cur.Len() > 0 {
+		w.list = append(w.list, w.cur.String())
+		w.cur.Reset()
+	}
+}
@@ -0,0 +1,62 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFormat(t *testing.T) {
+	r := Renderer{Format: NewPretty()}
+
+	Convey(`Inline elements should stay on the line of the text around them`, t, func() {
+		s := render(r, Div(P(Text("Hello "), Span.Text("world"), Text("!")), P.Text("second")))
+		So(s, ShouldEqual, "<div>\n  <p>Hello <span>world</span>!</p>\n  <p>second</p>\n</div>")
+	})
+
+	Convey(`Text should wrap at the width only where it has whitespace`, t, func() {
+		f := NewPretty()
+		f.MaxWidth = 24
+		s := render(Renderer{Format: f}, P(Text("one two three "), B.Text("four"), Text("five six")))
+		So(s, ShouldEqual, "<p>\n  one two three\n  <b>four</b>five six\n</p>")
+	})
+
+	Convey(`Pre and raw text elements should keep their whitespace`, t, func() {
+		s := render(r, Div(Pre.Text("  a\n    b"), Textarea.Text(" x\n"), Script.Text("f();\ng();")))
+		So(s, ShouldEqual, "<div>\n  <pre>  a\n    b</pre>\n  <textarea> x\n</textarea>\n  <script>f();\ng();</script>\n</div>")
+	})
+
+	Convey(`Attributes should wrap when the start tag doesn't fit`, t, func() {
+		f := NewPretty()
+		f.MaxWidth = 30
+		s := render(Renderer{Format: f}, Form.Atts("action", "/search/results", "method", "post")(Div.Text("x")))
+		So(s, ShouldEqual, "<form\n  action=\"/search/results\"\n  method=\"post\">\n  <div>x</div>\n</form>")
+
+		f.Wrap = false
+		s = render(Renderer{Format: f}, Form.Atts("action", "/search/results", "method", "post")(Div.Text("x")))
+		So(s, ShouldEqual, "<form action=\"/search/results\" method=\"post\">\n  <div>x</div>\n</form>")
+	})
+
+	Convey(`Formatters should be able to change the layout of elements`, t, func() {
+		s := render(Renderer{Format: cardLayout{NewPretty()}}, Div(E("x-card")(P.Text("a"))))
+		So(s, ShouldEqual, "<div>\n  <x-card>\n    <p>a</p>\n  </x-card>\n</div>")
+	})
+
+	Convey(`Whitespace between blocks should be dropped`, t, func() {
+		v, err := ParseString("<ul>\n\n   <li>a</li>   <li>b</li>\n</ul>")
+		So(err, ShouldBeNil)
+		So(render(r, v), ShouldEqual, "<ul>\n  <li>a</li>\n  <li>b</li>\n</ul>")
+	})
+}
+
+// cardLayout lays out x-card elements as blocks.
+type cardLayout struct {
+	Pretty
+}
+
+func (f cardLayout) Layout(e *Node) Layout {
+	if e.Tag == "x-card" {
+		return Block
+	}
+	return f.Pretty.Layout(e)
+}
@@ -0,0 +1,282 @@
+// Package gelhttp serves Views over net/http, taking care of the headers,
+// status codes, error pages, HEAD requests and gzip compression which
+// every handler rendering html otherwise repeats.
+//
+//	http.Handle("/report", gelhttp.NewHandler(func(r *http.Request) (gel.View, error) {
+//		rows, err := load(r)
+//		if err != nil {
+//			return nil, gelhttp.NewError(http.StatusNotFound, err)
+//		}
+//		return report(rows), nil
+//	}))
+package gelhttp
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lcaballero/gel"
+)
+
+// Error is an error with the http status to respond with.  Message is
+// shown on the error page, while Err is kept out of the response since it
+// may hold details of the server.
+type Error struct {
+	Status  int
+	Message string
+	Err     error
+}
+
+// NewError creates an Error with the status which wraps err, and whose
+// Message is the text of the status.
+func NewError(status int, err error) *Error {
+	return &Error{Status: status, Message: http.StatusText(status), Err: err}
+}
+
+// Errorf creates an Error with the status whose Message is formatted
+// using Sprintf, and which is shown to the user.
+func Errorf(status int, format string, args ...interface{}) *Error {
+	msg := fmt.Sprintf(format, args...)
+	return &Error{Status: status, Message: msg, Err: errors.New(msg)}
+}
+
+// Error returns the status with the wrapped error.
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%d %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("%d %s: %v", e.Status, e.Message, e.Err)
+}
+
+// Unwrap returns the wrapped error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Response is a View along with the status and headers to respond with,
+// for the views which don't respond with 200 OK.
+type Response struct {
+	Status int
+	Header http.Header
+	View   gel.View
+}
+
+// NewResponse creates a Response with the status and View.
+func NewResponse(status int, v gel.View) *Response {
+	return &Response{Status: status, Header: http.Header{}, View: v}
+}
+
+// Set sets a header of the Response.
+func (res *Response) Set(key, value string) *Response {
+	res.Header.Set(key, value)
+	return res
+}
+
+// ToNode implements gel.View, producing the Node of the View.
+func (res *Response) ToNode() *gel.Node {
+	if res.View == nil {
+		return gel.None().ToNode()
+	}
+	return res.View.ToNode()
+}
+
+// Handler is an http.Handler which responds with the View returned by Get.
+// When Get returns an error the ErrorPage is rendered instead, with the
+// status of an *Error, or 500 Internal Server Error for any other error
+// and for an *Error without a valid status.
+type Handler struct {
+	Get       func(*http.Request) (gel.View, error)
+	Renderer  gel.Renderer
+	ErrorPage func(*http.Request, *Error) gel.View
+	// Gzip compresses responses for clients which accept it.
+	Gzip bool
+	// ErrorLog logs errors returned by Get and errors writing the
+	// response, or the log package's standard logger when nil.
+	ErrorLog *log.Logger
+}
+
+// NewHandler creates a Handler for the func which renders html with the
+// DefaultErrorPage and gzip compression.
+func NewHandler(get func(*http.Request) (gel.View, error)) *Handler {
+	return &Handler{
+		Get:       get,
+		ErrorPage: DefaultErrorPage,
+		Gzip:      true,
+	}
+}
+
+// DefaultErrorPage is a Document showing the status and Message of the
+// Error.
+func DefaultErrorPage(r *http.Request, err *Error) gel.View {
+	title := fmt.Sprintf("%d %s", err.Status, http.StatusText(err.Status))
+	return gel.NewDocument(title, gel.H1.Text(title), gel.P.Text(err.Message))
+}
+
+// ServeHTTP renders the View, or the error page, with its headers.  The
+// body is left out when responding to a HEAD request.  Any gel.ViewFuncs
+// are resolved with the context of the request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := http.StatusOK
+	v, err := h.Get(r)
+	if err != nil {
+		var e *Error
+		if !errors.As(err, &e) {
+			e = NewError(http.StatusInternalServerError, err)
+		}
+		if !validStatus(e.Status) {
+			c := *e
+			c.Status = http.StatusInternalServerError
+			e = &c
+		}
+		if e.Status >= http.StatusInternalServerError {
+			h.logf("gelhttp: %s %s: %v", r.Method, r.URL.Path, err)
+		}
+		status = e.Status
+		v = gel.None()
+		if h.ErrorPage != nil {
+			v = h.ErrorPage(r, e)
+		}
+	}
+	if v == nil {
+		v = gel.None()
+	}
+
+	header := w.Header()
+	if res, ok := v.(*Response); ok {
+		if validStatus(res.Status) {
+			status = res.Status
+		}
+		for key, values := range res.Header {
+			header[key] = values
+		}
+	}
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", ContentType(h.Renderer.Mode))
+	}
+
+	// HEAD responds with the headers of GET, so the encoding is negotiated
+	// before the body is left out.
+	gzipped := false
+	if h.Gzip && status != http.StatusNoContent && status != http.StatusNotModified {
+		header.Add("Vary", "Accept-Encoding")
+		if AcceptsGzip(r) {
+			header.Set("Content-Encoding", "gzip")
+			header.Del("Content-Length")
+			gzipped = true
+		}
+	}
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+
+	var out io.Writer = w
+	if gzipped {
+		gz := gzip.NewWriter(w)
+		defer func() {
+			if err := gz.Close(); err != nil {
+				h.logf("gelhttp: %s %s: %v", r.Method, r.URL.Path, err)
+			}
+		}()
+		out = &gzipWriter{gz: gz, w: w}
+	}
+	w.WriteHeader(status)
+	// Rendering stops quietly once the client has gone away.
+	_, err = h.Renderer.RenderContext(r.Context(), out, v)
+	if err != nil && r.Context().Err() == nil {
+		h.logf("gelhttp: %s %s: %v", r.Method, r.URL.Path, err)
+	}
+}
+
+// validStatus reports whether the status can be written by a
+// ResponseWriter, which panics for codes outside 100-999.
+func validStatus(status int) bool {
+	return status >= 100 && status <= 999
+}
+
+func (h *Handler) logf(format string, args ...interface{}) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+// ContentType returns the Content-Type of the output of the mode.  XHTML
+// is served as xml, which the XHTML mode writes well formed.
+func ContentType(mode gel.Mode) string {
+	switch mode {
+	case gel.XHTML:
+		return "application/xhtml+xml; charset=utf-8"
+	case gel.XML:
+		return "application/xml; charset=utf-8"
+	}
+	return "text/html; charset=utf-8"
+}
+
+// AcceptsGzip reports whether the Accept-Encoding of the request allows
+// a gzip response, either by naming gzip or with the "*" wildcard.
+func AcceptsGzip(r *http.Request) bool {
+	gz, star := -1.0, -1.0
+	for _, field := range r.Header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(field, ",") {
+			name, params := coding, ""
+			if i := strings.IndexByte(coding, ';'); i >= 0 {
+				name, params = coding[:i], coding[i+1:]
+			}
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "gzip":
+				gz = quality(params)
+			case "*":
+				star = quality(params)
+			}
+		}
+	}
+	if gz >= 0 {
+		return gz > 0
+	}
+	return star > 0
+}
+
+// quality returns the q parameter of an Accept-Encoding coding, which is
+// 1 when it isn't given.
+func quality(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		param = strings.TrimSpace(param)
+		if strings.HasPrefix(param, "q=") {
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil {
+				return 0
+			}
+			return q
+		}
+	}
+	return 1
+}
+
+// gzipWriter compresses the output to the ResponseWriter, and implements
+// gel.Flusher so that streamed output is sent as it is rendered.
+type gzipWriter struct {
+	gz *gzip.Writer
+	w  http.ResponseWriter
+}
+
+func (g *gzipWriter) Write(b []byte) (int, error) {
+	return g.gz.Write(b)
+}
+
+// Flush sends the compressed output on to the client.
+func (g *gzipWriter) Flush() {
+	if g.gz.Flush() != nil {
+		return
+	}
+	if f, ok := g.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
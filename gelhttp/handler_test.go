@@ -0,0 +1,163 @@
+package gelhttp
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lcaballero/gel"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func serve(h http.Handler, method string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/page", nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func page(r *http.Request) (gel.View, error) {
+	return gel.Div.Text("hello " + r.URL.Path), nil
+}
+
+func TestHandler(t *testing.T) {
+	quiet := log.New(ioutil.Discard, "", 0)
+
+	Convey(`The View should be rendered as html`, t, func() {
+		w := serve(NewHandler(page), http.MethodGet)
+		So(w.Code, ShouldEqual, http.StatusOK)
+		So(w.Header().Get("Content-Type"), ShouldEqual, "text/html; charset=utf-8")
+		So(w.Body.String(), ShouldEqual, `<div>hello /page</div>`)
+	})
+
+	Convey(`The Content-Type should follow the mode of the Renderer`, t, func() {
+		h := NewHandler(page)
+		h.Renderer.Mode = gel.XML
+		w := serve(h, http.MethodGet)
+		So(w.Header().Get("Content-Type"), ShouldEqual, "application/xml; charset=utf-8")
+	})
+
+	Convey(`XHTML Documents should be served as well formed xhtml`, t, func() {
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return gel.NewDocument("t", gel.P.Text("a & b"), gel.Script.Text("if (a < b && c) {}")), nil
+		})
+		h.Renderer.Mode = gel.XHTML
+		h.Gzip = false
+		w := serve(h, http.MethodGet)
+		So(w.Header().Get("Content-Type"), ShouldEqual, "application/xhtml+xml; charset=utf-8")
+		So(w.Body.String(), ShouldContainSubstring, `<html xmlns="http://www.w3.org/1999/xhtml" lang="en">`)
+
+		d := xml.NewDecoder(w.Body)
+		for {
+			_, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			So(err, ShouldBeNil)
+		}
+	})
+
+	Convey(`A Response should set the status and headers`, t, func() {
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return NewResponse(http.StatusCreated, gel.P.Text("made")).Set("Location", "/new"), nil
+		})
+		w := serve(h, http.MethodGet)
+		So(w.Code, ShouldEqual, http.StatusCreated)
+		So(w.Header().Get("Location"), ShouldEqual, "/new")
+		So(w.Body.String(), ShouldEqual, `<p>made</p>`)
+	})
+
+	Convey(`Errors should render the error page with their status`, t, func() {
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return nil, Errorf(http.StatusNotFound, "no page at %s", r.URL.Path)
+		})
+		w := serve(h, http.MethodGet)
+		So(w.Code, ShouldEqual, http.StatusNotFound)
+		So(w.Body.String(), ShouldContainSubstring, `<title>404 Not Found</title>`)
+		So(w.Body.String(), ShouldContainSubstring, `<p>no page at /page</p>`)
+	})
+
+	Convey(`Other errors should be a 500 without their details`, t, func() {
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return nil, errors.New("db password rejected")
+		})
+		h.ErrorLog = quiet
+		w := serve(h, http.MethodGet)
+		So(w.Code, ShouldEqual, http.StatusInternalServerError)
+		So(w.Body.String(), ShouldContainSubstring, `Internal Server Error`)
+		So(w.Body.String(), ShouldNotContainSubstring, `password`)
+	})
+
+	Convey(`Errors without a valid status should be a 500`, t, func() {
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return nil, &Error{Message: "x"}
+		})
+		h.ErrorLog = quiet
+		w := serve(h, http.MethodGet)
+		So(w.Code, ShouldEqual, http.StatusInternalServerError)
+		So(w.Body.String(), ShouldContainSubstring, `<p>x</p>`)
+	})
+
+	Convey(`HEAD requests should get the headers without a body`, t, func() {
+		w := serve(NewHandler(page), http.MethodHead)
+		So(w.Code, ShouldEqual, http.StatusOK)
+		So(w.Header().Get("Content-Type"), ShouldEqual, "text/html; charset=utf-8")
+		So(w.Body.Len(), ShouldEqual, 0)
+
+		w = serve(NewHandler(page), http.MethodHead, "Accept-Encoding", "gzip")
+		So(w.Header().Get("Content-Encoding"), ShouldEqual, "gzip")
+		So(w.Header().Get("Vary"), ShouldEqual, "Accept-Encoding")
+		So(w.Body.Len(), ShouldEqual, 0)
+	})
+
+	Convey(`Responses should be compressed when the client accepts gzip`, t, func() {
+		w := serve(NewHandler(page), http.MethodGet, "Accept-Encoding", "br, gzip")
+		So(w.Header().Get("Content-Encoding"), ShouldEqual, "gzip")
+		So(w.Header().Get("Vary"), ShouldEqual, "Accept-Encoding")
+		gz, err := gzip.NewReader(w.Body)
+		So(err, ShouldBeNil)
+		body, err := ioutil.ReadAll(gz)
+		So(err, ShouldBeNil)
+		So(string(body), ShouldEqual, `<div>hello /page</div>`)
+
+		w = serve(NewHandler(page), http.MethodGet, "Accept-Encoding", "gzip;q=0, *")
+		So(w.Header().Get("Content-Encoding"), ShouldEqual, "")
+		So(w.Body.String(), ShouldEqual, `<div>hello /page</div>`)
+	})
+
+	Convey(`Streams should be flushed through the compression`, t, func() {
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return gel.Ul(gel.StreamSlice(3, func(i int) gel.View { return gel.Li.Fmt("%d", i) })), nil
+		})
+		w := serve(h, http.MethodGet, "Accept-Encoding", "gzip")
+		So(w.Flushed, ShouldBeTrue)
+		gz, err := gzip.NewReader(w.Body)
+		So(err, ShouldBeNil)
+		body, err := ioutil.ReadAll(gz)
+		So(err, ShouldBeNil)
+		So(string(body), ShouldEqual, `<ul><li>0</li><li>1</li><li>2</li></ul>`)
+	})
+	Convey(`ViewFuncs should be resolved with the context of the request`, t, func() {
+		type key struct{}
+		h := NewHandler(func(r *http.Request) (gel.View, error) {
+			return gel.P(gel.ViewFunc(func(ctx context.Context) gel.View {
+				return gel.Text(ctx.Value(key{}).(string))
+			})), nil
+		})
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), key{}, "from the request"))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		So(w.Body.String(), ShouldEqual, `<p>from the request</p>`)
+	})
+}
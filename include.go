@@ -20,22 +20,41 @@ func NewInserter(res Resolver, reader FileReader) Inserter {
 	}
 }
 
+// Include reads the file as text, which is escaped when rendered.
 func (r Inserter) Include(file string) View {
+	b, ok := r.read(file)
+	if !ok {
+		return None()
+	}
+	return Text(string(b))
+}
+
+// IncludeRaw reads the file as trusted markup, such as an html partial,
+// which is written unescaped when rendered.
+func (r Inserter) IncludeRaw(file string) View {
+	b, ok := r.read(file)
+	if !ok {
+		return None()
+	}
+	return RawBytes(b)
+}
+
+func (r Inserter) read(file string) ([]byte, bool) {
 	fqname := r.resolver(file)
 	_, err := os.Stat(fqname)
 	if os.IsNotExist(err) {
 		log.Println("file doesn't exist", fqname)
-		return None()
+		return nil, false
 	}
 	if err != nil {
 		log.Println(err)
-		return None()
+		return nil, false
 	}
 
 	b, err := r.reader(fqname)
 	if err != nil {
 		log.Println("didn't file file to include", file, fqname)
-		return None()
+		return nil, false
 	}
-	return Text(string(b))
+	return b, true
 }
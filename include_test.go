@@ -0,0 +1,25 @@
+package gel
+
+import (
+	"io/ioutil"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInclude(t *testing.T) {
+
+	Convey(`Include should escape the file while IncludeRaw keeps the markup`, t, func() {
+		dir := t.TempDir()
+		in := NewInserter(
+			func(f string) string { return dir + "/" + f },
+			ioutil.ReadFile,
+		)
+		err := ioutil.WriteFile(dir+"/part.html", []byte("<b>hi</b>"), 0644)
+		So(err, ShouldBeNil)
+
+		So(Div(in.Include("part.html")).ToNode().String(), ShouldEqual, "<div>&lt;b&gt;hi&lt;/b&gt;</div>")
+		So(Div(in.IncludeRaw("part.html")).ToNode().String(), ShouldEqual, "<div><b>hi</b></div>")
+		So(in.IncludeRaw("missing.html").ToNode().String(), ShouldEqual, "")
+	})
+}
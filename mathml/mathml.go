@@ -0,0 +1,17 @@
+// Package mathml provides the Tags of the MathML Core elements and
+// attributes for writing equations which browsers render without any
+// script, composing with the Views of package gel.
+//
+// The elements are in the MathML Namespace, so a Renderer in the XML or
+// XHTML mode declares it on the outermost math element, while in the HTML
+// mode empty elements like mspace are written self closed.
+package mathml
+
+import (
+	"github.com/lcaballero/gel"
+)
+
+//go:generate go run ../cmd/gentags -in mathml.go.tpl -out mathml_gen.go
+
+// NS is the default MathML Namespace of the elements.
+var NS = gel.NewNamespace("", gel.MathMLNamespace)
@@ -0,0 +1,84 @@
+---
+# Elements of MathML Core.
+elements:
+  - Annotation
+  - AnnotationXml annotation-xml
+  - Math
+  - Merror
+  - Mfrac
+  - Mi
+  - Mmultiscripts
+  - Mn
+  - Mo
+  - Mover
+  - Mpadded
+  - Mphantom
+  - Mprescripts
+  - Mroot
+  - Mrow
+  - Ms
+  - Mspace
+  - Msqrt
+  - Mstyle
+  - Msub
+  - Msubsup
+  - Msup
+  - Mtable
+  - Mtd
+  - Mtext
+  - Mtr
+  - Munder
+  - Munderover
+  - Semantics
+# Attributes of MathML Core elements.
+attrs:
+  - Accent
+  - Accentunder
+  - Columnspan
+  - Depth
+  - Display
+  - Displaystyle
+  - Encoding
+  - Fence
+  - Form
+  - Height
+  - Largeop
+  - Linethickness
+  - Lspace
+  - Mathvariant
+  - Maxsize
+  - Minsize
+  - Movablelimits
+  - Rowspan
+  - Rspace
+  - Scriptlevel
+  - Separator
+  - Stretchy
+  - Symmetric
+  - Voffset
+  - Width
+---
+{{ .GEN_TAGLINE }}
+
+package mathml
+
+import (
+  "github.com/lcaballero/gel"
+)
+
+var (
+  {{ range .elements }}{{ ident . }} gel.Tag = NS.E("{{ name . }}")
+  {{ end }}
+)
+
+// Tags maps each element name to its Tag.
+var Tags = map[string]gel.Tag{
+  {{ range .elements }}"{{ name . }}": {{ ident . }},
+  {{ end }}
+}
+{{ range .attrs }}
+// {{ ident . }} creates the {{ name . }} attribute with the given value.
+func {{ ident . }}(value string) gel.View {
+  return gel.Att("{{ name . }}", value)
+}
+{{ end }}
@@ -0,0 +1,197 @@
+// Code generated by gentags from mathml.go.tpl; DO NOT EDIT.
+
+package mathml
+
+import (
+	"github.com/lcaballero/gel"
+)
+
+var (
+	Annotation    gel.Tag = NS.E("annotation")
+	AnnotationXml gel.Tag = NS.E("annotation-xml")
+	Math          gel.Tag = NS.E("math")
+	Merror        gel.Tag = NS.E("merror")
+	Mfrac         gel.Tag = NS.E("mfrac")
+	Mi            gel.Tag = NS.E("mi")
+	Mmultiscripts gel.Tag = NS.E("mmultiscripts")
+	Mn            gel.Tag = NS.E("mn")
+	Mo            gel.Tag = NS.E("mo")
+	Mover         gel.Tag = NS.E("mover")
+	Mpadded       gel.Tag = NS.E("mpadded")
+	Mphantom      gel.Tag = NS.E("mphantom")
+	Mprescripts   gel.Tag = NS.E("mprescripts")
+	Mroot         gel.Tag = NS.E("mroot")
+	Mrow          gel.Tag = NS.E("mrow")
+	Ms            gel.Tag = NS.E("ms")
+	Mspace        gel.Tag = NS.E("mspace")
+	Msqrt         gel.Tag = NS.E("msqrt")
+	Mstyle        gel.Tag = NS.E("mstyle")
+	Msub          gel.Tag = NS.E("msub")
+	Msubsup       gel.Tag = NS.E("msubsup")
+	Msup          gel.Tag = NS.E("msup")
+	Mtable        gel.Tag = NS.E("mtable")
+	Mtd           gel.Tag = NS.E("mtd")
+	Mtext         gel.Tag = NS.E("mtext")
+	Mtr           gel.Tag = NS.E("mtr")
+	Munder        gel.Tag = NS.E("munder")
+	Munderover    gel.Tag = NS.E("munderover")
+	Semantics     gel.Tag = NS.E("semantics")
+)
+
+// Tags maps each element name to its Tag.
+var Tags = map[string]gel.Tag{
+	"annotation":     Annotation,
+	"annotation-xml": AnnotationXml,
+	"math":           Math,
+	"merror":         Merror,
+	"mfrac":          Mfrac,
+	"mi":             Mi,
+	"mmultiscripts":  Mmultiscripts,
+	"mn":             Mn,
+	"mo":             Mo,
+	"mover":          Mover,
+	"mpadded":        Mpadded,
+	"mphantom":       Mphantom,
+	"mprescripts":    Mprescripts,
+	"mroot":          Mroot,
+	"mrow":           Mrow,
+	"ms":             Ms,
+	"mspace":         Mspace,
+	"msqrt":          Msqrt,
+	"mstyle":         Mstyle,
+	"msub":           Msub,
+	"msubsup":        Msubsup,
+	"msup":           Msup,
+	"mtable":         Mtable,
+	"mtd":            Mtd,
+	"mtext":          Mtext,
+	"mtr":            Mtr,
+	"munder":         Munder,
+	"munderover":     Munderover,
+	"semantics":      Semantics,
+}
+
+// Accent creates the accent attribute with the given value.
+func Accent(value string) gel.View {
+	return gel.Att("accent", value)
+}
+
+// Accentunder creates the accentunder attribute with the given value.
+func Accentunder(value string) gel.View {
+	return gel.Att("accentunder", value)
+}
+
+// Columnspan creates the columnspan attribute with the given value.
+func Columnspan(value string) gel.View {
+	return gel.Att("columnspan", value)
+}
+
+// Depth creates the depth attribute with the given value.
+func Depth(value string) gel.View {
+	return gel.Att("depth", value)
+}
+
+// Display creates the display attribute with the given value.
+func Display(value string) gel.View {
+	return gel.Att("display", value)
+}
+
+// Displaystyle creates the displaystyle attribute with the given value.
+func Displaystyle(value string) gel.View {
+	return gel.Att("displaystyle", value)
+}
+
+// Encoding creates the encoding attribute with the given value.
+func Encoding(value string) gel.View {
+	return gel.Att("encoding", value)
+}
+
+// Fence creates the fence attribute with the given value.
+func Fence(value string) gel.View {
+	return gel.Att("fence", value)
+}
+
+// Form creates the form attribute with the given value.
+func Form(value string) gel.View {
+	return gel.Att("form", value)
+}
+
+// Height creates the height attribute with the given value.
+func Height(value string) gel.View {
+	return gel.Att("height", value)
+}
+
+// Largeop creates the largeop attribute with the given value.
+func Largeop(value string) gel.View {
+	return gel.Att("largeop", value)
+}
+
+// Linethickness creates the linethickness attribute with the given value.
+func Linethickness(value string) gel.View {
+	return gel.Att("linethickness", value)
+}
+
+// Lspace creates the lspace attribute with the given value.
+func Lspace(value string) gel.View {
+	return gel.Att("lspace", value)
+}
+
+// Mathvariant creates the mathvariant attribute with the given value.
+func Mathvariant(value string) gel.View {
+	return gel.Att("mathvariant", value)
+}
+
+// Maxsize creates the maxsize attribute with the given value.
+func Maxsize(value string) gel.View {
+	return gel.Att("maxsize", value)
+}
+
+// Minsize creates the minsize attribute with the given value.
+func Minsize(value string) gel.View {
+	return gel.Att("minsize", value)
+}
+
+// Movablelimits creates the movablelimits attribute with the given value.
+func Movablelimits(value string) gel.View {
+	return gel.Att("movablelimits", value)
+}
+
+// Rowspan creates the rowspan attribute with the given value.
+func Rowspan(value string) gel.View {
+	return gel.Att("rowspan", value)
+}
+
+// Rspace creates the rspace attribute with the given value.
+func Rspace(value string) gel.View {
+	return gel.Att("rspace", value)
+}
+
+// Scriptlevel creates the scriptlevel attribute with the given value.
+func Scriptlevel(value string) gel.View {
+	return gel.Att("scriptlevel", value)
+}
+
+// Separator creates the separator attribute with the given value.
+func Separator(value string) gel.View {
+	return gel.Att("separator", value)
+}
+
+// Stretchy creates the stretchy attribute with the given value.
+func Stretchy(value string) gel.View {
+	return gel.Att("stretchy", value)
+}
+
+// Symmetric creates the symmetric attribute with the given value.
+func Symmetric(value string) gel.View {
+	return gel.Att("symmetric", value)
+}
+
+// Voffset creates the voffset attribute with the given value.
+func Voffset(value string) gel.View {
+	return gel.Att("voffset", value)
+}
+
+// Width creates the width attribute with the given value.
+func Width(value string) gel.View {
+	return gel.Att("width", value)
+}
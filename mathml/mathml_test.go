@@ -0,0 +1,54 @@
+package mathml
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lcaballero/gel"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func render(mode gel.Mode, v gel.View) string {
+	buf := bytes.NewBuffer([]byte{})
+	gel.Renderer{Mode: mode}.Render(buf, v)
+	return buf.String()
+}
+
+// quadratic builds x = (-b ± √(b²-4ac)) / 2a.
+func quadratic() gel.View {
+	return Math.With(Display("block"))(
+		Mi.Text("x"), Mo.Text("="),
+		Mfrac(
+			Mrow(
+				Mo.Text("-"), Mi.Text("b"), Mo.Text("±"),
+				Msqrt(Msup(Mi.Text("b"), Mn.Text("2")), Mo.Text("-"), Mn.Text("4"), Mi.Text("a"), Mi.Text("c")),
+			),
+			Mrow(Mn.Text("2"), Mi.Text("a")),
+		),
+	)
+}
+
+func TestMathML(t *testing.T) {
+
+	Convey(`Equations should render in html without a namespace declaration`, t, func() {
+		So(render(gel.HTML, quadratic()), ShouldEqual, `<math display="block"><mi>x</mi><mo>=</mo><mfrac>`+
+			`<mrow><mo>-</mo><mi>b</mi><mo>±</mo><msqrt><msup><mi>b</mi><mn>2</mn></msup>`+
+			`<mo>-</mo><mn>4</mn><mi>a</mi><mi>c</mi></msqrt></mrow>`+
+			`<mrow><mn>2</mn><mi>a</mi></mrow></mfrac></math>`)
+	})
+
+	Convey(`Empty elements should be self closed in html`, t, func() {
+		s := render(gel.HTML, gel.P(Math(Mi.Text("a"), Mspace(Width("1em")), Mi.Text("b"))))
+		So(s, ShouldEqual, `<p><math><mi>a</mi><mspace width="1em"/><mi>b</mi></math></p>`)
+	})
+
+	Convey(`XHTML mode should declare the MathML namespace on the math element`, t, func() {
+		s := render(gel.XHTML, Math(Mn.Text("1")))
+		So(s, ShouldEqual, `<math xmlns="http://www.w3.org/1998/Math/MathML"><mn>1</mn></math>`)
+	})
+
+	Convey(`Tags should be found by element name`, t, func() {
+		So(Tags["annotation-xml"], ShouldNotBeNil)
+		So(len(Tags), ShouldEqual, 29)
+	})
+}
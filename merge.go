@@ -0,0 +1,165 @@
+package gel
+
+import (
+	"strings"
+)
+
+// MergeFunc combines an Attribute being added to an Element with the
+// Attribute of the same key already on the Element.  It returns the
+// Attribute which replaces prev, or nil to keep both.  Neither prev nor next
+// should be modified, since Attribute Views are often shared by many Tags.
+type MergeFunc func(prev, next *Node) *Node
+
+// MergeAttributes is the default MergeFunc.  Class values are joined as a
+// set of tokens, style declarations are merged by property, and for any
+// other key the last value added wins.
+func MergeAttributes(prev, next *Node) *Node {
+	if prev.IsBool || next.IsBool {
+		return next
+	}
+	switch strings.ToLower(next.Key) {
+	case "class":
+		return merged(prev, next, mergeClass(prev.Value, next.Value))
+	case "style":
+		return merged(prev, next, mergeStyle(prev.Value, next.Value))
+	}
+	return next
+}
+
+// AppendAttributes is a MergeFunc which keeps every Attribute added,
+// including duplicates.
+func AppendAttributes(prev, next *Node) *Node {
+	return nil
+}
+
+// addAttribute adds the Attribute to the Element, merging it with an
+// existing Attribute of the same key.
+func (e *Node) addAttribute(att *Node) {
+	merge := e.Merge
+	if merge == nil {
+		merge = MergeAttributes
+	}
+	for i, prev := range e.Attributes {
+		if !strings.EqualFold(prev.Key, att.Key) {
+			continue
+		}
+		if m := merge(prev, att); m != nil {
+			e.Attributes[i] = m
+			return
+		}
+	}
+	e.Attributes = append(e.Attributes, att)
+}
+
+// merged creates the Attribute combining prev and next with the given value,
+// which is only Trusted when both of them were.
+func merged(prev, next *Node, value string) *Node {
+	return &Node{
+		Type:      Attribute,
+		Key:       prev.Key,
+		Value:     value,
+		Trusted:   prev.Trusted && next.Trusted,
+		Namespace: prev.Namespace,
+	}
+}
+
+// mergeClass joins the class tokens of both values, dropping duplicates.
+func mergeClass(prev, next string) string {
+	tokens := strings.Fields(prev)
+	for _, tok := range strings.Fields(next) {
+		if !contains(tokens, tok) {
+			tokens = append(tokens, tok)
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// mergeStyle combines the declarations of both values, where the
+// declarations of next override those of prev for the same property.
+func mergeStyle(prev, next string) string {
+	decls := parseStyle(prev)
+	for _, d := range parseStyle(next) {
+		decls = decls.set(d.prop, d.value)
+	}
+	return decls.String()
+}
+
+// declaration is a single css property and value pair.
+type declaration struct {
+	prop  string
+	value string
+}
+
+// declarations are css declarations in the order they were first set.
+type declarations []declaration
+
+// set replaces the value of the property or appends it.
+func (ds declarations) set(prop, value string) declarations {
+	for i, d := range ds {
+		if strings.EqualFold(d.prop, prop) {
+			ds[i].value = value
+			return ds
+		}
+	}
+	return append(ds, declaration{prop: prop, value: value})
+}
+
+// String renders the declarations as the value of a style attribute.
+func (ds declarations) String() string {
+	var b strings.Builder
+	for i, d := range ds {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(d.prop)
+		b.WriteString(": ")
+		b.WriteString(d.value)
+	}
+	return b.String()
+}
+
+// parseStyle splits a style attribute value into its declarations,
+// ignoring semicolons within quotes or parentheses as in url("a;b").
+func parseStyle(style string) declarations {
+	var ds declarations
+	depth, quote, start := 0, byte(0), 0
+	for i := 0; i <= len(style); i++ {
+		if i < len(style) {
+			c := style[i]
+			switch {
+			case c == '\\':
+				// An escaped character never ends a string or declaration.
+				i++
+				continue
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+				continue
+			case c == '"' || c == '\'':
+				quote = c
+				continue
+			case c == '(':
+				depth++
+				continue
+			case c == ')':
+				depth--
+				continue
+			case c != ';' || depth > 0:
+				continue
+			}
+		}
+		decl := style[start:i]
+		start = i + 1
+		colon := strings.IndexByte(decl, ':')
+		if colon < 0 {
+			continue
+		}
+		prop := strings.TrimSpace(decl[:colon])
+		value := strings.TrimSpace(decl[colon+1:])
+		if prop != "" {
+			ds = ds.set(prop, value)
+		}
+	}
+	return ds
+}
@@ -0,0 +1,57 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMerge(t *testing.T) {
+
+	Convey(`Adding a class twice should join the class tokens`, t, func() {
+		d := Div.Class("a b").Class("b c")().ToNode()
+		So(d.Attributes, ShouldHaveLength, 1)
+		So(d.String(), ShouldEqual, `<div class="a b c"></div>`)
+	})
+
+	Convey(`Mixing Atts with child Att views should not duplicate attributes`, t, func() {
+		d := Div.Atts("id", "a", "class", "row")(Att("id", "b"), Att("CLASS", "wide")).ToNode()
+		So(d.String(), ShouldEqual, `<div id="b" class="row wide"></div>`)
+	})
+
+	Convey(`Style declarations should be merged by property`, t, func() {
+		d := Div(
+			Att("style", "color: red; background: url('a;b.png')"),
+			Att("style", "margin:0;color:blue"),
+		).ToNode()
+		So(d.String(), ShouldEqual, `<div style="color: blue; background: url(&#39;a;b.png&#39;); margin: 0"></div>`)
+
+		d = Div(Att("style", `content: "\"; x"; color: red`), Att("style", "color: blue")).ToNode()
+		So(d.String(), ShouldEqual, `<div style="content: &#34;\&#34;; x&#34;; color: blue"></div>`)
+	})
+
+	Convey(`Merging should not modify shared attribute views`, t, func() {
+		row := Att("class", "row")
+		a := Div(row, Att("class", "a")).ToNode()
+		b := Div(row).ToNode()
+		So(a.String(), ShouldEqual, `<div class="row a"></div>`)
+		So(b.String(), ShouldEqual, `<div class="row"></div>`)
+	})
+
+	Convey(`The merge policy should be overridable per Node`, t, func() {
+		d := Div().ToNode()
+		d.Merge = AppendAttributes
+		d.Add(Att("class", "a"), Att("class", "b"))
+		So(d.String(), ShouldEqual, `<div class="a" class="b"></div>`)
+
+		first := Div().ToNode()
+		first.Merge = func(prev, next *Node) *Node { return prev }
+		first.Add(Att("id", "a"), Att("id", "b"))
+		So(first.String(), ShouldEqual, `<div id="a"></div>`)
+	})
+
+	Convey(`Attribute lists should keep every attribute`, t, func() {
+		list := Atts("class", "a", "class", "b").ToNode()
+		So(list.Children, ShouldHaveLength, 2)
+	})
+}
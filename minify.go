@@ -0,0 +1,201 @@
+package gel
+
+import (
+	"strings"
+)
+
+// defaultAttributes are attribute values which are the same as leaving the
+// attribute out, by element.
+var defaultAttributes = map[string]map[string]string{
+	"area":   {"shape": "rect"},
+	"button": {"type": "submit"},
+	"form":   {"method": "get", "enctype": "application/x-www-form-urlencoded"},
+	"input":  {"type": "text"},
+	"link":   {"type": "text/css"},
+	"script": {"type": "text/javascript", "language": "javascript"},
+	"style":  {"type": "text/css", "media": "all"},
+}
+
+// endsWithParent are the elements whose end tag can be omitted when they
+// are the last child of their parent, in addition to when followed by one
+// of the elements which closes them.
+var endsWithParent = map[string]bool{
+	"dd":       true,
+	"li":       true,
+	"optgroup": true,
+	"option":   true,
+	"p":        true,
+	"tbody":    true,
+	"td":       true,
+	"th":       true,
+	"tr":       true,
+}
+
+// transparent are the elements which can't be the parent of a p whose end
+// tag is omitted, since their content may be phrasing content.
+var transparent = map[string]bool{
+	"a":        true,
+	"audio":    true,
+	"del":      true,
+	"ins":      true,
+	"map":      true,
+	"noscript": true,
+	"video":    true,
+}
+
+// minifying reports whether the output is minified, which only applies to
+// the HTML mode.
+func (p *printer) minifying() bool {
+	return p.Minify && p.Mode == HTML
+}
+
+// minified writes the children of parent, dropping whitespace between
+// blocks, collapsing the whitespace of text and omitting optional end
+// tags.  Text within a pre element is written as is.
+func (p *printer) minified(parent *Node, kids []*Node, esc Escaping) {
+	p.minifiedRun(parent, kids, esc, false)
+}
+
+// minifiedRun writes the children like minified, continuing a run of text
+// which ended in a space when space is true, as it does between the views
+// of a Stream.  It reports whether the text written last ends in a space.
+func (p *printer) minifiedRun(parent *Node, kids []*Node, esc Escaping, space bool) bool {
+	kids = p.significant(parent, p.resolved(kids))
+	for i, kid := range kids {
+		if kid.Type == Textual && p.pre == 0 {
+			// Adjacent text collapses as one run of whitespace.
+			s := collapse(kid.CData)
+			if space {
+				s = strings.TrimPrefix(s, " ")
+			}
+			space = strings.HasSuffix(s, " ") || (space && s == "")
+			p.text(kid, esc, s)
+			continue
+		}
+		space = false
+		if kid.Type == Element {
+			var next *Node
+			if i+1 < len(kids) {
+				next = kids[i+1]
+			}
+			p.omit = optionalEnd(kid, parent, next)
+		}
+		p.node(kid, Indent{}, esc)
+	}
+	return space
+}
+
+// significant returns the nodes which are written, leaving out empty text,
+// hoisted nodes, and whitespace with a block on either side.
+func (p *printer) significant(parent *Node, kids []*Node) []*Node {
+	out := make([]*Node, 0, len(kids))
+	for _, kid := range kids {
+		if kid.Type == Hoisted || (kid.Type == Textual && kid.CData == "") {
+			continue
+		}
+		out = append(out, kid)
+	}
+	if p.pre > 0 {
+		return out
+	}
+	kept := out[:0]
+	for i, kid := range out {
+		if kid.Type == Textual && strings.TrimFunc(kid.CData, isSpaceRune) == "" {
+			var prev, next *Node
+			if i > 0 {
+				prev = out[i-1]
+			}
+			if i+1 < len(out) {
+				next = out[i+1]
+			}
+			if isBoundary(prev, parent) && isBoundary(next, parent) {
+				continue
+			}
+		}
+		kept = append(kept, kid)
+	}
+	return kept
+}
+
+// isBoundary reports whether whitespace next to the sibling is
+// insignificant, since the sibling is a block element, or there is no
+// sibling and the parent is a block element.  Text, markup and comments
+// are never boundaries.
+func isBoundary(sibling, parent *Node) bool {
+	e := sibling
+	if e == nil {
+		e = parent
+	}
+	return e.Type == Element && blockElements[e.Tag]
+}
+
+// optionalEnd reports whether the end tag of the element can be left out,
+// given its parent and the node written after it, if any.
+func optionalEnd(e, parent, next *Node) bool {
+	if next == nil {
+		// Only the last child of an element is followed by its end tag,
+		// since a NodeList may have siblings of its own.
+		if parent.Type != Element {
+			return false
+		}
+		if e.Tag == "p" && (transparent[parent.Tag] || strings.Contains(parent.Tag, "-")) {
+			return false
+		}
+		return endsWithParent[e.Tag]
+	}
+	return next.Type == Element && contains(closedBy[e.Tag], next.Tag)
+}
+
+// minAttribute writes the value of an attribute without quotes when it
+// is safe to, or leaves it out when it is empty.
+func (p *printer) minAttribute(e *Node) {
+	value := p.capture(func() { p.text(e, AttrEscaping(e.Key), e.Value) })
+	switch {
+	case value == "":
+	case unquoted(value):
+		p.out.byte('=')
+		p.out.str(value)
+	default:
+		p.out.str(`="`)
+		p.out.str(value)
+		p.out.byte('"')
+	}
+}
+
+// isDefault reports whether the attribute holds the value the element
+// has without it.
+func isDefault(e, att *Node) bool {
+	value, ok := defaultAttributes[e.Tag][strings.ToLower(att.Key)]
+	return ok && !att.IsBool && strings.EqualFold(strings.TrimSpace(att.Value), value)
+}
+
+// unquoted reports whether an escaped attribute value can be written
+// without quotes.
+func unquoted(value string) bool {
+	return value != "" && !strings.ContainsAny(value, " \t\n\r\f\"'=<>`")
+}
+
+// collapse replaces each run of whitespace in s with a single space.
+func collapse(s string) string {
+	var sb strings.Builder
+	space := false
+	for i := 0; i < len(s); i++ {
+		if isSpace(s[i]) {
+			space = true
+			continue
+		}
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+		sb.WriteByte(s[i])
+	}
+	if space {
+		sb.WriteByte(' ')
+	}
+	return sb.String()
+}
+
+func isSpaceRune(r rune) bool {
+	return r < 0x80 && isSpace(byte(r))
+}
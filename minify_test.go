@@ -0,0 +1,79 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMinify(t *testing.T) {
+	r := Renderer{Minify: true}
+
+	Convey(`Whitespace should be collapsed in text and dropped between blocks`, t, func() {
+		s := render(r, Div(Text("\n  "), P(Text("a   lot\n of "), Text("  space")), Text("\n"), P.Text("b")))
+		So(s, ShouldEqual, `<div><p>a lot of space<p>b</div>`)
+	})
+
+	Convey(`Whitespace between inline elements should be kept`, t, func() {
+		s := render(r, Span(B.Text("a"), Text("   "), I.Text("b")))
+		So(s, ShouldEqual, `<span><b>a</b> <i>b</i></span>`)
+	})
+
+	Convey(`Whitespace between text and markup should be kept`, t, func() {
+		s := render(r, P(Text("Hello,"), Text(" "), Text("World")))
+		So(s, ShouldEqual, `<p>Hello, World</p>`)
+
+		s = render(r, P(Raw("<b>x</b>"), Text(" "), Text("World")))
+		So(s, ShouldEqual, `<p><b>x</b> World</p>`)
+	})
+
+	Convey(`Pre should keep its whitespace`, t, func() {
+		s := render(r, Div(Pre(Text("  a\n\n  b"), B.Text(" c "))))
+		So(s, ShouldEqual, "<div><pre>  a\n\n  b<b> c </b></pre></div>")
+	})
+
+	Convey(`Optional end tags should be left out`, t, func() {
+		s := render(r, Ul(Li.Text("a"), Li.Text("b")))
+		So(s, ShouldEqual, `<ul><li>a<li>b</ul>`)
+
+		s = render(r, Table(Tbody(Tr(Td.Text("1"), Td.Text("2")), Tr(Td.Text("3")))))
+		So(s, ShouldEqual, `<table><tbody><tr><td>1<td>2<tr><td>3</table>`)
+
+		s = render(r, Select(Option.Text("a"), Option.Text("b")))
+		So(s, ShouldEqual, `<select><option>a<option>b</select>`)
+	})
+
+	Convey(`End tags should be kept when the next node doesn't imply them`, t, func() {
+		s := render(r, Div(P.Text("a"), Span.Text("b")))
+		So(s, ShouldEqual, `<div><p>a</p><span>b</span></div>`)
+
+		s = render(r, A.Href("/")(P.Text("a")))
+		So(s, ShouldEqual, `<a href=/><p>a</p></a>`)
+
+		s = render(r, Div(StreamSlice(1, func(i int) View { return Frag(P.Text("a")) }), Span.Text("b")))
+		So(s, ShouldEqual, `<div><p>a</p><span>b</span></div>`)
+	})
+
+	Convey(`Attribute values should only be quoted when they need to be`, t, func() {
+		s := render(r, Div.Atts("id", "main", "class", "a b", "title", "", "data-x", `x"y`)())
+		So(s, ShouldEqual, `<div id=main class="a b" title data-x=x&#34;y></div>`)
+	})
+
+	Convey(`Attributes holding their default value should be left out`, t, func() {
+		s := render(r, Form.Method("GET")(Input.Type("text").Name("q")(), Script.Type("text/javascript").Text("f()")))
+		So(s, ShouldEqual, `<form><input name=q><script>f()</script></form>`)
+	})
+
+	Convey(`Views pulled from a Stream should be minified`, t, func() {
+		s := render(r, P(StreamSlice(2, func(i int) View { return Text("a   b  ") })))
+		So(s, ShouldEqual, `<p>a b a b </p>`)
+
+		s = render(r, P(StreamSlice(2, func(i int) View { return Frag(Text("  x   "), B.Text("y")) })))
+		So(s, ShouldEqual, `<p> x <b>y</b> x <b>y</b></p>`)
+	})
+
+	Convey(`Other modes should not be minified`, t, func() {
+		s := render(Renderer{Minify: true, Mode: XHTML}, Ul(Li.Text("a")))
+		So(s, ShouldEqual, `<ul><li>a</li></ul>`)
+	})
+}
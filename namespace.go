@@ -0,0 +1,117 @@
+package gel
+
+import (
+	"strings"
+)
+
+// Well known namespace URIs.
+const (
+	XHTMLNamespace  = "http://www.w3.org/1999/xhtml"
+	SVGNamespace    = "http://www.w3.org/2000/svg"
+	MathMLNamespace = "http://www.w3.org/1998/Math/MathML"
+	XLinkNamespace  = "http://www.w3.org/1999/xlink"
+	XMLNamespace    = "http://www.w3.org/XML/1998/namespace"
+)
+
+// XMLHeader is the xml declaration which starts an xml document.
+const XMLHeader = `<?xml version="1.0" encoding="UTF-8"?>`
+
+// XMLDecl creates the xml declaration which starts an xml document.
+func XMLDecl() View {
+	return Raw(XMLHeader)
+}
+
+// Namespace pairs an xml namespace URI with the prefix used for the names
+// of its elements and attributes.  An empty Prefix is the default
+// namespace, so names are written without a prefix.
+//
+// When rendering in the XML and XHTML modes, the Renderer writes the xmlns
+// declarations for the namespaces used by an element or its attributes
+// where they aren't already in scope.
+type Namespace struct {
+	Prefix string
+	URI    string
+}
+
+// NewNamespace creates a Namespace with the given prefix and URI.
+func NewNamespace(prefix, uri string) Namespace {
+	return Namespace{Prefix: prefix, URI: uri}
+}
+
+// Name returns the qualified name, prefix:local, of a name in the
+// Namespace.
+func (ns Namespace) Name(local string) string {
+	if ns.Prefix == "" {
+		return local
+	}
+	return ns.Prefix + ":" + local
+}
+
+// E creates a Tag for the element, in the Namespace, that can hold
+// children.
+func (ns Namespace) E(local string) Tag {
+	return ns.El(local, false)
+}
+
+// El creates a Tag for the element in the Namespace.
+func (ns Namespace) El(local string, isVoid bool) Tag {
+	tag := El(ns.Name(local), isVoid)
+	return func(children ...View) View {
+		node := tag().ToNode()
+		node.Namespace = ns.URI
+		return node.Add(children...)
+	}
+}
+
+// Att creates an Attribute in the Namespace.
+func (ns Namespace) Att(local, value string) View {
+	node := Att(ns.Name(local), value).ToNode()
+	node.Namespace = ns.URI
+	return node
+}
+
+// Xmlns creates the Attribute declaring the Namespace.
+func (ns Namespace) Xmlns() View {
+	if ns.Prefix == "" {
+		return Att("xmlns", ns.URI)
+	}
+	return Att("xmlns:"+ns.Prefix, ns.URI)
+}
+
+// binding is a namespace prefix in scope while rendering.
+type binding struct {
+	prefix string
+	uri    string
+}
+
+// scope holds the namespace bindings in effect, innermost last.
+type scope []binding
+
+// lookup returns the URI bound to the prefix.
+func (s scope) lookup(prefix string) (string, bool) {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i].prefix == prefix {
+			return s[i].uri, true
+		}
+	}
+	return "", false
+}
+
+// xmlnsPrefix returns the prefix declared by an xmlns attribute.
+func xmlnsPrefix(key string) (string, bool) {
+	if key == "xmlns" {
+		return "", true
+	}
+	if strings.HasPrefix(key, "xmlns:") {
+		return key[len("xmlns:"):], true
+	}
+	return "", false
+}
+
+// prefixOf returns the prefix of a qualified name.
+func prefixOf(name string) string {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	atom = NewNamespace("atom", "http://www.w3.org/2005/Atom")
+	rss  = E("rss")
+)
+
+func TestNamespace(t *testing.T) {
+
+	Convey(`XML mode should declare the namespaces used which aren't in scope`, t, func() {
+		feed := Frag(
+			XMLDecl(),
+			rss.Atts("version", "2.0")(
+				E("channel")(
+					atom.E("link")(Att("href", "https://example.com/feed"), Att("rel", "self")),
+					E("title").Text("News & Views"),
+					atom.E("link")(),
+				),
+			),
+		)
+		So(render(Renderer{Mode: XML}, feed), ShouldEqual, XMLHeader+
+			`<rss version="2.0"><channel>`+
+			`<atom:link xmlns:atom="http://www.w3.org/2005/Atom" href="https://example.com/feed" rel="self"/>`+
+			`<title>News &amp; Views</title>`+
+			`<atom:link xmlns:atom="http://www.w3.org/2005/Atom"/>`+
+			`</channel></rss>`)
+	})
+
+	Convey(`Namespaces declared by an ancestor should not be declared again`, t, func() {
+		feed := rss(atom.Xmlns(), E("channel")(atom.E("link")()))
+		So(render(Renderer{Mode: XML}, feed), ShouldEqual,
+			`<rss xmlns:atom="http://www.w3.org/2005/Atom"><channel><atom:link/></channel></rss>`)
+	})
+
+	Convey(`Prefixed attributes should declare their namespace`, t, func() {
+		xlink := NewNamespace("xlink", XLinkNamespace)
+		svg := NewNamespace("", SVGNamespace)
+		s := render(Renderer{Mode: XML}, svg.E("svg")(svg.E("use")(xlink.Att("href", "#icon"), Att("xml:lang", "en"))))
+		So(s, ShouldEqual, `<svg xmlns="http://www.w3.org/2000/svg">`+
+			`<use xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="#icon" xml:lang="en"/></svg>`)
+	})
+
+	Convey(`HTML mode should not add namespace declarations`, t, func() {
+		So(render(Renderer{}, atom.E("link")()), ShouldEqual, `<atom:link></atom:link>`)
+	})
+
+	Convey(`XHTML mode should declare namespaces and close void elements`, t, func() {
+		xhtml := NewNamespace("", XHTMLNamespace)
+		s := render(Renderer{Mode: XHTML}, xhtml.E("html")(Body(Br())))
+		So(s, ShouldEqual, `<html xmlns="http://www.w3.org/1999/xhtml"><body><br /></body></html>`)
+	})
+
+	Convey(`XML mode should drop characters xml doesn't allow`, t, func() {
+		s := render(Renderer{Mode: XML}, E("note")(Att("a", "x\x00y"), Text("bell\x07 tab\t")))
+		So(s, ShouldEqual, "<note a=\"xy\">bell tab\t</note>")
+		So(ValidXML("ok \U0001F600"), ShouldEqual, "ok \U0001F600")
+	})
+}
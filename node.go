@@ -1,19 +1,35 @@
 package gel
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"strings"
 )
 
 // Nodes represent the different parts of of Html as one type.  A single Node
 // can be one and only one of the following types: Textual, Element, or
 // Attribute.  In general a Textual node will not have children, but will
 // have CData, Elements can have both children of type Text, Element,
-// Attributes or Fragment, but cannot directly hold CData.  Attributes have
-// only Key and Value strings, and all other fields are empty or nil.  Lastly,
-// Fragments can have children of type Text and Element, while all other
-// fields are empty or nil.
+// Attributes or Fragment, but cannot directly hold CData.  Markup nodes are
+// like Textual nodes, but their CData is trusted html written unescaped.
+// Attributes have only Key and Value strings, and all other fields are empty
+// or nil, except for boolean Attributes which are flagged with IsBool and
+// render only their Key.  Hoisted nodes hold children which a Document
+// moves into its head.  Comment nodes hold their text as CData, and
+// conditional comments also hold their condition as the Key and the
+// markup they enclose as Children.  Streamed nodes have no Children, but
+// Pull the views to render one at a time, returning nil once done or once
+// the context of the render is, and Contextual nodes Resolve the
+// view to render from the context of the render.  Lastly, Fragments can have
+// children of type Text and Element, while all other fields are empty or
+// nil.
+//
+// Elements and Attributes created from a Namespace hold its URI as their
+// Namespace, and their Tag or Key is the prefixed name.
+//
+// CData and attribute Values are escaped when rendered according to where
+// they land in the document, unless the Node is marked as Trusted.
 type Node struct {
 	Tag        string
 	Children   []*Node
@@ -23,12 +39,19 @@ type Node struct {
 	Value      string
 	CData      string
 	IsVoid     bool
+	IsBool     bool
+	Trusted    bool
+	Merge      MergeFunc
+	Namespace  string
+	Pull       func(context.Context) View
+	Resolve    func(context.Context) View
 }
 
 // WriteTo will output the Node to the writer correctly nesting children and
-// attributes.
-func (e *Node) WriteTo(w io.Writer) {
-	e.WriteToIndented(Indent{}, w)
+// attributes.  It implements io.WriterTo, returning the number of bytes
+// written and the first error encountered, at which point writing stops.
+func (e *Node) WriteTo(w io.Writer) (int64, error) {
+	return e.WriteToIndented(Indent{}, w)
 }
 
 // ToNode is implemented to conform to a component pattern of Nodes within
@@ -38,101 +61,47 @@ func (e *Node) ToNode() *Node {
 	return e
 }
 
-// WriteTo writes the Node to the given writer with the given indention.
-func (e *Node) WriteToIndented(in Indent, w io.Writer) {
-	switch e.Type {
-	case Textual:
-		if in.HasIndent() && e.CData != "" {
-			in.WriteTo(w)
-		}
-		if e.CData != "" {
-			w.Write([]byte(e.CData))
-		}
-		if in.HasIndent() && e.CData != "" {
-			w.Write([]byte("\n"))
-		}
-	case Attribute:
-		w.Write([]byte(" "))
-		w.Write([]byte(e.Key))
-		w.Write([]byte("=\""))
-		w.Write([]byte(e.Value))
-		w.Write([]byte("\""))
-	case AttributeList:
-		for _, at := range e.Children {
-			at.WriteToIndented(in, w)
-		}
-	case NodeList:
-		for _, f := range e.Children {
-			f.WriteToIndented(in, w)
-		}
-	case Element:
-		if in.HasIndent() {
-			in.WriteTo(w)
-		}
-		w.Write([]byte("<"))
-		w.Write([]byte(e.Tag))
-		if len(e.Attributes) > 0 {
-			for _, att := range e.Attributes {
-				att.WriteTo(w)
-			}
-		}
-		if e.IsVoid {
-			w.Write([]byte("/>"))
-		} else {
-			w.Write([]byte(">"))
-			if len(e.Children) > 0 {
-				if in.HasIndent() {
-					w.Write([]byte("\n"))
-				}
-				next := in.Incr()
-				for _, kid := range e.Children {
-					kid.WriteToIndented(next, w)
-				}
-			}
-		}
-		if in.Level > 0 && in.HasIndent() && len(e.Children) > 0 {
-			in.WriteTo(w)
-		}
-		if !e.IsVoid {
-			w.Write([]byte(fmt.Sprintf("</%s>", e.Tag)))
-		}
-		if in.Level > 0 {
-			w.Write([]byte("\n"))
-		}
-	}
+// WriteToIndented writes the Node to the given writer with the given
+// indention, returning the number of bytes written and the first error.
+func (e *Node) WriteToIndented(in Indent, w io.Writer) (int64, error) {
+	return Renderer{Indent: in}.Render(w, e)
 }
 
 // String renders the Node as html (text, element, or attribute).
 func (e *Node) String() string {
-	buf := bytes.NewBuffer([]byte{})
-	e.WriteTo(buf)
-	return buf.String()
+	var sb strings.Builder
+	e.WriteTo(&sb)
+	return sb.String()
 }
 
-// Add will collect and bucket the nodes into atts and children.  Nodes
-// of type Text or Element are added to children and Attribute type are
-// added to the Atts slice.  If the Node is not an Element then
-// attributes will silently be ignored.
+// Add will collect and bucket the nodes into atts and children.  Nodes of
+// type Text, Markup, Element, Hoisted, Comment, Streamed or Contextual are
+// added to children and Attribute type are added to the Atts slice.  An
+// Attribute with the same key as one already on an Element is combined with
+// it using the Element's Merge policy, which defaults to MergeAttributes.
+// If the Node is not an Element then attributes will silently be ignored.
 func (v *Node) Add(nodes ...View) View {
 	dest := v.ToNode()
 	for _, view := range nodes {
 		src := view.ToNode()
 		switch src.Type {
-		case Textual, Element:
+		case Textual, Markup, Element, Hoisted, Comment, Streamed, Contextual:
 			dest.Children = append(dest.Children, src)
 		case NodeList:
 			dest.Children = append(dest.Children, src.Children...)
 		case Attribute:
 			switch dest.Type {
 			case Element:
-				dest.Attributes = append(dest.Attributes, src)
+				dest.addAttribute(src)
 			case AttributeList:
 				dest.Children = append(dest.Children, src)
 			}
 		case AttributeList:
 			switch dest.Type {
 			case Element:
-				dest.Attributes = append(dest.Attributes, src.Children...)
+				for _, att := range src.Children {
+					dest.addAttribute(att)
+				}
 			case AttributeList:
 				dest.Children = append(dest.Children, src.Children...)
 			}
@@ -159,6 +128,44 @@ func Att(key, value string) View {
 	return node
 }
 
+// Bool creates a boolean Attribute which is rendered without a value, as
+// in <input disabled>.
+func Bool(key string) View {
+	node := &Node{
+		Type:   Attribute,
+		Key:    key,
+		IsBool: true,
+	}
+	return node
+}
+
+// BoolIf creates the boolean Attribute when cond is true, and otherwise
+// an empty AttributeList so that the attribute is omitted.
+func BoolIf(cond bool, key string) View {
+	if !cond {
+		return Atts()
+	}
+	return Bool(key)
+}
+
+// AttIf creates the Attribute when cond is true, and otherwise an empty
+// AttributeList so that the attribute is omitted.
+func AttIf(cond bool, key, value string) View {
+	if !cond {
+		return Atts()
+	}
+	return Att(key, value)
+}
+
+// TrustedAtt creates an Attribute Node whose value is written exactly as
+// given, without escaping or URL filtering.  Only use it for values that
+// do not come from user input.
+func TrustedAtt(key, value string) View {
+	node := Att(key, value).ToNode()
+	node.Trusted = true
+	return node
+}
+
 // Atts attempts to pair up parameters and make an AttributeList.
 func Atts(pairs ...string) View {
 	node := &Node{
@@ -180,6 +187,48 @@ func Text(c string) View {
 	return node
 }
 
+// TrustedText is the same as Raw.
+func TrustedText(c string) View {
+	return Raw(c)
+}
+
+// Raw creates a Markup Node that writes the given html exactly as given,
+// without escaping, which allows embedding markup that is already known to
+// be safe, like the output of a markdown renderer.
+func Raw(html string) View {
+	node := &Node{
+		Type:  Markup,
+		CData: html,
+	}
+	return node
+}
+
+// RawBytes creates a Markup Node from the given html bytes.
+func RawBytes(html []byte) View {
+	return Raw(string(html))
+}
+
+// Cmt creates an html Comment holding the text.  The text is sanitized
+// when rendered so that it can't end the comment early.
+func Cmt(text string) View {
+	node := &Node{
+		Type:  Comment,
+		CData: text,
+	}
+	return node
+}
+
+// CondCmt creates a conditional Comment, which encloses the children in
+// <!--[if cond]> and <![endif]-->.
+func CondCmt(cond string, children ...View) View {
+	node := &Node{
+		Type:     Comment,
+		Key:      cond,
+		Children: make([]*Node, 0),
+	}
+	return node.Add(children...)
+}
+
 // Fmt creates a Text node using Sprintf.
 func Fmt(format string, args ...interface{}) View {
 	s := fmt.Sprintf(format, args...)
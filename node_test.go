@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"bytes"
+	"errors"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -150,7 +151,7 @@ func TestGel(t *testing.T) {
 	Convey(`void tags should not have a closing tag`, t, func() {
 		buf := bytes.NewBuffer([]byte{})
 		Meta().ToNode().WriteToIndented(NewIndent(), buf)
-		So(buf.String(), ShouldEqual, "<meta/>")
+		So(buf.String(), ShouldEqual, "<meta>")
 	})
 
 	Convey(`div using Add(...) many atts should render as <div class="container" id="id-1">text</div>`, t, func() {
@@ -281,4 +282,89 @@ func TestGel(t *testing.T) {
 		s := Div().ToNode().String()
 		So(s, ShouldEqual, "<div></div>")
 	})
+
+	Convey(`Raw should add an unescaped Markup child`, t, func() {
+		d := Div(Raw("<b>bold</b>"), Text("<i>")).ToNode()
+		So(d.Children, ShouldHaveLength, 2)
+		So(d.Children[0].Type, ShouldEqual, Markup)
+		So(d.String(), ShouldEqual, "<div><b>bold</b>&lt;i&gt;</div>")
+	})
+
+	Convey(`Raw markup inside a Frag should be added as a child`, t, func() {
+		d := Div(Frag(RawBytes([]byte("<hr/>")))).ToNode()
+		So(d.Children, ShouldHaveLength, 1)
+		So(d.Children[0].Type.String(), ShouldEqual, "Markup")
+	})
+
+	Convey(`WriteTo should report the bytes written`, t, func() {
+		buf := bytes.NewBuffer([]byte{})
+		n, err := Div.Class("row").Text("a").ToNode().WriteTo(buf)
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, buf.Len())
+	})
+
+	Convey(`WriteTo should stop on and return the first write error`, t, func() {
+		w := &failingWriter{limit: 7}
+		n, err := Div(Div.Text("a"), Div.Text("b")).ToNode().WriteTo(w)
+		So(err, ShouldEqual, errWriteFailed)
+		So(n, ShouldEqual, 7)
+		So(w.buf.String(), ShouldEqual, "<div><d")
+		So(w.afterFailure, ShouldEqual, 0)
+	})
+
+	Convey(`Elements of the living standard should be generated`, t, func() {
+		d := Details(Summary.Text("more"), Dialog(), Search(), Menu(), Hgroup(), Picture(), Slot())
+		So(d.ToNode().String(), ShouldEqual,
+			`<details><summary>more</summary><dialog></dialog><search></search><menu></menu><hgroup></hgroup><picture></picture><slot></slot></details>`)
+		So(Tags["dialog"], ShouldNotBeNil)
+		So(IsVoid("PARAM"), ShouldBeTrue)
+	})
+
+	Convey(`Typed attribute helpers should add their attribute`, t, func() {
+		s := A.Href("/home").Rel("nofollow").ID("home").Text("Home").ToNode().String()
+		So(s, ShouldEqual, `<a href="/home" rel="nofollow" id="home">Home</a>`)
+
+		s = Input.Type("checkbox").Name("ok").Disabled()().ToNode().String()
+		So(s, ShouldEqual, `<input type="checkbox" name="ok" disabled>`)
+
+		s = Meta.HttpEquiv("refresh").Content("5")().ToNode().String()
+		So(s, ShouldEqual, `<meta http-equiv="refresh" content="5">`)
+	})
+
+	Convey(`Boolean attributes should render without a value`, t, func() {
+		s := Option(Bool("selected"), Att("value", "1")).ToNode().String()
+		So(s, ShouldEqual, `<option selected value="1"></option>`)
+		So(Bool("hidden").ToNode().IsBool, ShouldBeTrue)
+	})
+
+	Convey(`Conditional attributes should be omitted when false`, t, func() {
+		s := Input(BoolIf(false, "checked"), BoolIf(true, "required"), AttIf(false, "value", "x")).ToNode()
+		So(s.Attributes, ShouldHaveLength, 1)
+		So(s.String(), ShouldEqual, `<input required>`)
+		So(Div(AttIf(true, "id", "a")).ToNode().String(), ShouldEqual, `<div id="a"></div>`)
+	})
+}
+
+var errWriteFailed = errors.New("write failed")
+
+// failingWriter accepts up to limit bytes, after which every write fails
+// and further attempts to write are counted.
+type failingWriter struct {
+	buf          bytes.Buffer
+	limit        int
+	failed       bool
+	afterFailure int
+}
+
+func (f *failingWriter) Write(b []byte) (int, error) {
+	if f.failed {
+		f.afterFailure++
+		return 0, errWriteFailed
+	}
+	if f.buf.Len()+len(b) > f.limit {
+		n, _ := f.buf.Write(b[:f.limit-f.buf.Len()])
+		f.failed = true
+		return n, errWriteFailed
+	}
+	return f.buf.Write(b)
 }
@@ -0,0 +1,404 @@
+package gel
+
+import (
+	"errors"
+	"html"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// ErrUnclosedTag is returned by Parse when the input ends in the middle of
+// a tag.
+var ErrUnclosedTag = errors.New("input ended before the end of a tag")
+
+// rawTextElements hold text up to their end tag without any markup, and
+// the escapable ones also decode character references.
+var rawTextElements = map[string]bool{
+	"script":   false,
+	"style":    false,
+	"textarea": true,
+	"title":    true,
+}
+
+// closedBy maps an element to the start tags which implicitly end it, for
+// the common cases where html allows end tags to be omitted.
+var closedBy = map[string][]string{
+	"li":       {"li"},
+	"dt":       {"dt", "dd"},
+	"dd":       {"dt", "dd"},
+	"option":   {"option", "optgroup"},
+	"optgroup": {"optgroup"},
+	"tr":       {"tr", "tbody", "tfoot"},
+	"td":       {"td", "th", "tr", "tbody", "tfoot"},
+	"th":       {"td", "th", "tr", "tbody", "tfoot"},
+	"thead":    {"tbody", "tfoot"},
+	"tbody":    {"tbody", "tfoot"},
+	"p": {
+		"address", "article", "aside", "blockquote", "details", "dialog",
+		"div", "dl", "fieldset", "figcaption", "figure", "footer", "form",
+		"h1", "h2", "h3", "h4", "h5", "h6", "header", "hgroup", "hr", "main",
+		"menu", "nav", "ol", "p", "pre", "search", "section", "table", "ul",
+	},
+}
+
+// IsVoid reports whether the named html element is a void element, which
+// has no children and no end tag.
+func IsVoid(tag string) bool {
+	return voidElements[strings.ToLower(tag)]
+}
+
+// Parse reads html from r and builds the equivalent tree of Nodes.  A
+// document with a single top level element produces that Element, otherwise
+// the top level nodes are returned as a NodeList.  Character references are
+// decoded, so Text nodes hold the literal text and are escaped again when
+// rendered.  Comments become Comment nodes, while doctypes and conditional
+// comments are kept as Markup.  Like a browser, Parse closes elements left
+// open and ignores unmatched end tags.
+func Parse(r io.Reader) (View, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{src: string(b)}
+	p.open = []*Node{Frag().ToNode()}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	root := p.open[0]
+	if el := soleElement(root); el != nil {
+		return el, nil
+	}
+	return root, nil
+}
+
+// soleElement returns the only Element of the list when every other node
+// is whitespace, otherwise nil.
+func soleElement(list *Node) *Node {
+	var el *Node
+	for _, kid := range list.Children {
+		switch {
+		case kid.Type == Element && el == nil:
+			el = kid
+		case kid.Type == Textual && strings.TrimSpace(kid.CData) == "":
+		default:
+			return nil
+		}
+	}
+	return el
+}
+
+// ParseString parses the html held in s.
+func ParseString(s string) (View, error) {
+	return Parse(strings.NewReader(s))
+}
+
+// parser holds the input and the stack of currently open elements, where
+// the bottom of the stack is the NodeList holding the top level nodes.
+type parser struct {
+	src     string
+	pos     int
+	open    []*Node
+	foreign int
+}
+
+func (p *parser) parse() error {
+	for p.pos < len(p.src) {
+		lt := strings.IndexByte(p.src[p.pos:], '<')
+		if lt < 0 {
+			p.text(p.src[p.pos:])
+			return nil
+		}
+		p.text(p.src[p.pos : p.pos+lt])
+		p.pos += lt
+		rest := p.src[p.pos:]
+		var err error
+		switch {
+		case strings.HasPrefix(rest, "<!--[if"):
+			err = p.markup("-->")
+		case strings.HasPrefix(rest, "<!--"):
+			err = p.comment()
+		case strings.HasPrefix(rest, "<!"), strings.HasPrefix(rest, "<?"):
+			err = p.markup(">")
+		case strings.HasPrefix(rest, "</") && len(rest) > 2 && isLetter(rest[2]):
+			err = p.endTag()
+		case len(rest) > 1 && isLetter(rest[1]):
+			err = p.startTag()
+		default:
+			p.text("<")
+			p.pos++
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// top returns the innermost open node.
+func (p *parser) top() *Node {
+	return p.open[len(p.open)-1]
+}
+
+func (p *parser) text(s string) {
+	if s == "" {
+		return
+	}
+	p.top().Add(Text(html.UnescapeString(s)))
+}
+
+// comment adds the text of a comment as a Comment.
+func (p *parser) comment() error {
+	i := strings.Index(p.src[p.pos+4:], "-->")
+	if i < 0 {
+		return ErrUnclosedTag
+	}
+	p.top().Add(Cmt(p.src[p.pos+4 : p.pos+4+i]))
+	p.pos += 4 + i + 3
+	return nil
+}
+
+// markup keeps a conditional comment, doctype or processing instruction,
+// which ends with the given terminator, as Markup.
+func (p *parser) markup(end string) error {
+	i := strings.Index(p.src[p.pos:], end)
+	if i < 0 {
+		return ErrUnclosedTag
+	}
+	i += p.pos + len(end)
+	p.top().Add(Raw(p.src[p.pos:i]))
+	p.pos = i
+	return nil
+}
+
+func (p *parser) endTag() error {
+	p.pos += 2
+	name := p.name()
+	i := strings.IndexByte(p.src[p.pos:], '>')
+	if i < 0 {
+		return ErrUnclosedTag
+	}
+	p.pos += i + 1
+	for k := len(p.open) - 1; k > 0; k-- {
+		if strings.EqualFold(p.open[k].Tag, name) {
+			p.close(k)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (p *parser) startTag() error {
+	p.pos++
+	name := p.name()
+	if p.foreign == 0 {
+		name = strings.ToLower(name)
+	}
+	p.implyEnd(name)
+	el := E(name)().ToNode()
+	el.IsVoid = p.foreign == 0 && voidElements[name]
+	foreign := p.foreign > 0 || name == "svg" || name == "math"
+	selfClosed, err := p.attributes(el, !foreign)
+	if err != nil {
+		return err
+	}
+	p.top().Add(el)
+	if el.IsVoid || selfClosed {
+		return nil
+	}
+	if p.foreign == 0 {
+		if escapable, ok := rawTextElements[name]; ok {
+			return p.rawText(el, escapable)
+		}
+	}
+	if foreign {
+		p.foreign++
+	}
+	p.open = append(p.open, el)
+	return nil
+}
+
+// attributes reads the attributes of a start tag up to and including the
+// closing '>', reporting whether the tag was self-closed with "/>".  Names
+// are lower cased unless the element is foreign content like svg.
+func (p *parser) attributes(el *Node, lower bool) (bool, error) {
+	seen := map[string]bool{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return false, ErrUnclosedTag
+		}
+		switch {
+		case p.src[p.pos] == '>':
+			p.pos++
+			return false, nil
+		case strings.HasPrefix(p.src[p.pos:], "/>"):
+			p.pos += 2
+			return true, nil
+		case p.src[p.pos] == '/':
+			p.pos++
+			continue
+		}
+		key := p.attName()
+		if lower {
+			key = strings.ToLower(key)
+		}
+		att := Bool(key)
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == '=' {
+			p.pos++
+			p.skipSpace()
+			v, err := p.attValue()
+			if err != nil {
+				return false, err
+			}
+			att = Att(key, html.UnescapeString(v))
+		}
+		if !seen[key] {
+			seen[key] = true
+			el.Add(att)
+		}
+	}
+}
+
+func (p *parser) attValue() (string, error) {
+	if p.pos >= len(p.src) {
+		return "", ErrUnclosedTag
+	}
+	q := p.src[p.pos]
+	if q == '"' || q == '\'' {
+		end := strings.IndexByte(p.src[p.pos+1:], q)
+		if end < 0 {
+			return "", ErrUnclosedTag
+		}
+		v := p.src[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return v, nil
+	}
+	start := p.pos
+	for p.pos < len(p.src) && !isSpace(p.src[p.pos]) && p.src[p.pos] != '>' {
+		p.pos++
+	}
+	return p.src[start:p.pos], nil
+}
+
+// rawText reads the text content of a raw text element up to its end tag.
+func (p *parser) rawText(el *Node, escapable bool) error {
+	end := "</" + el.Tag
+	i := p.pos
+	for {
+		k := strings.Index(p.src[i:], "</")
+		if k < 0 {
+			i = len(p.src)
+			break
+		}
+		i += k
+		after := i + len(end)
+		if after <= len(p.src) && strings.EqualFold(p.src[i:after], end) {
+			if after == len(p.src) || isSpace(p.src[after]) || p.src[after] == '>' || p.src[after] == '/' {
+				break
+			}
+		}
+		i += 2
+	}
+	body := p.src[p.pos:i]
+	if escapable {
+		body = html.UnescapeString(body)
+	}
+	if body != "" {
+		el.Add(Text(body))
+	}
+	p.pos = i
+	if i < len(p.src) {
+		gt := strings.IndexByte(p.src[i:], '>')
+		if gt < 0 {
+			return ErrUnclosedTag
+		}
+		p.pos = i + gt + 1
+	}
+	return nil
+}
+
+// implyEnd closes the open elements whose end tag is implied by the start
+// of the named element, such as an <li> ending the previous <li>.
+func (p *parser) implyEnd(name string) {
+	for p.implyEndOnce(name) {
+	}
+}
+
+// implyEndOnce closes the innermost element whose end tag is implied,
+// searching only through elements whose end tag can be omitted, and
+// reports if one was closed.
+func (p *parser) implyEndOnce(name string) bool {
+	for k := len(p.open) - 1; k > 0; k-- {
+		tag := p.open[k].Tag
+		if contains(closedBy[tag], name) {
+			p.close(k)
+			return true
+		}
+		if !isImplicitlyClosed(tag) {
+			return false
+		}
+	}
+	return false
+}
+
+// close pops the open element at index k along with everything opened
+// inside of it.
+func (p *parser) close(k int) {
+	for i := len(p.open) - 1; i >= k && p.foreign > 0; i-- {
+		p.foreign--
+	}
+	p.open = p.open[:k]
+}
+
+func (p *parser) name() string {
+	start := p.pos
+	for p.pos < len(p.src) && !isSpace(p.src[p.pos]) && p.src[p.pos] != '>' && p.src[p.pos] != '/' {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *parser) attName() string {
+	start := p.pos
+	p.pos++
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if isSpace(c) || c == '>' || c == '/' || c == '=' {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// isImplicitlyClosed reports whether an element can have its end tag
+// implied by a following start tag.
+func isImplicitlyClosed(tag string) bool {
+	_, ok := closedBy[tag]
+	return ok
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isLetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
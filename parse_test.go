@@ -0,0 +1,89 @@
+package gel
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+
+	Convey(`Parsing html should build an equivalent tree of Nodes`, t, func() {
+		v, err := ParseString(`<div class="row" id=main><p>Hello, <b>World</b>!</p><br><img src="a.png"/></div>`)
+		So(err, ShouldBeNil)
+		n := v.ToNode()
+		So(n.Type, ShouldEqual, Element)
+		So(n.Tag, ShouldEqual, "div")
+		So(n.Attributes, ShouldHaveLength, 2)
+		So(n.Children, ShouldHaveLength, 3)
+		So(n.Children[1].IsVoid, ShouldBeTrue)
+		So(n.String(), ShouldEqual, `<div class="row" id="main"><p>Hello, <b>World</b>!</p><br><img src="a.png"></div>`)
+	})
+
+	Convey(`Parsing should decode character references, which are escaped again when rendered`, t, func() {
+		v, err := ParseString(`<p title="a &amp; &quot;b&quot;">1 &lt; 2 &copy;</p>`)
+		So(err, ShouldBeNil)
+		n := v.ToNode()
+		So(n.Attributes[0].Value, ShouldEqual, `a & "b"`)
+		So(n.Children[0].CData, ShouldEqual, "1 < 2 ©")
+		So(n.String(), ShouldEqual, `<p title="a &amp; &#34;b&#34;">1 &lt; 2 ©</p>`)
+	})
+
+	Convey(`Multiple top level nodes should produce a NodeList`, t, func() {
+		v, err := ParseString("<!DOCTYPE html>\n<html><head><title>a &lt; b</title></head><body></body></html>")
+		So(err, ShouldBeNil)
+		n := v.ToNode()
+		So(n.Type, ShouldEqual, NodeList)
+		So(n.Children, ShouldHaveLength, 3)
+		So(n.Children[0].Type, ShouldEqual, Markup)
+		So(n.String(), ShouldEqual, "<!DOCTYPE html>\n<html><head><title>a &lt; b</title></head><body></body></html>")
+	})
+
+	Convey(`A single element surrounded by whitespace should be returned as the root`, t, func() {
+		v, err := ParseString("\n  <ul><li>a</li></ul>\n")
+		So(err, ShouldBeNil)
+		So(v.ToNode().Tag, ShouldEqual, "ul")
+	})
+
+	Convey(`Script contents should be kept as text up to the end tag`, t, func() {
+		v, err := ParseString(`<script>if (a < b && "</scripts>") {}</SCRIPT>`)
+		So(err, ShouldBeNil)
+		n := v.ToNode()
+		So(n.Children, ShouldHaveLength, 1)
+		So(n.Children[0].CData, ShouldEqual, `if (a < b && "</scripts>") {}`)
+	})
+
+	Convey(`Omitted end tags should be implied`, t, func() {
+		v, err := ParseString(`<ul><li>a<li>b<p>c</ul><table><tr><td>1<td>2<tr><td>3</table>`)
+		So(err, ShouldBeNil)
+		So(v.ToNode().String(), ShouldEqual,
+			`<ul><li>a</li><li>b<p>c</p></li></ul><table><tr><td>1</td><td>2</td></tr><tr><td>3</td></tr></table>`)
+	})
+
+	Convey(`Tag and attribute names should be lower cased outside of svg`, t, func() {
+		v, err := ParseString(`<DIV CLASS="x"><svg viewBox="0 0 1 1"><linearGradient/></svg></DIV>`)
+		So(err, ShouldBeNil)
+		So(v.ToNode().String(), ShouldEqual, `<div class="x"><svg viewBox="0 0 1 1"><linearGradient></linearGradient></svg></div>`)
+	})
+
+	Convey(`Unmatched end tags should be ignored and open elements closed`, t, func() {
+		v, err := ParseString(`<div><span>a</p></div><em>b`)
+		So(err, ShouldBeNil)
+		So(v.ToNode().String(), ShouldEqual, `<div><span>a</span></div><em>b</em>`)
+	})
+
+	Convey(`Valueless attributes should be parsed as boolean attributes`, t, func() {
+		v, err := ParseString(`<input disabled value="">`)
+		So(err, ShouldBeNil)
+		n := v.ToNode()
+		So(n.Attributes[0].IsBool, ShouldBeTrue)
+		So(n.Attributes[1].IsBool, ShouldBeFalse)
+		So(n.String(), ShouldEqual, `<input disabled value="">`)
+	})
+
+	Convey(`Input ending inside a tag should be an error`, t, func() {
+		_, err := Parse(strings.NewReader(`<div class="a`))
+		So(err, ShouldEqual, ErrUnclosedTag)
+	})
+}
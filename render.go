@@ -0,0 +1,452 @@
+package gel
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// Mode selects the syntax used to serialize Nodes.
+type Mode int
+
+// The serialization modes.
+const (
+	// HTML writes void elements without an end tag or closing slash, as
+	// in <br>, and boolean attributes as just their name.
+	HTML Mode = iota
+	// XHTML writes void elements as <br />, boolean attributes with their
+	// name as their value, as in checked="checked", and declares the xhtml
+	// namespace on the html element.  Script and style holding < or & are
+	// wrapped in a CDATA section, commented out so that html parsers ignore
+	// it too.
+	XHTML
+	// XML writes every element without children as <name/>, boolean
+	// attributes with their name as their value, and escapes the text of
+	// every element, including script and style.
+	XML
+)
+
+// Renderer writes Views as html.  Output is written through a buffered
+// io.StringWriter so that rendering large documents doesn't issue a
+// syscall, or allocate a []byte, for every tag and attribute.
+//
+// Indent writes every node on its own line.  When Format is set it is used
+// instead, to lay out the html knowing which elements are inline.
+//
+// Minify writes html in as few bytes as browsers parse the same way,
+// ignoring Indent and Format.  It collapses the whitespace of text outside
+// of pre, drops whitespace between blocks, leaves out optional end tags
+// such as </li> and </p>, writes attribute values without quotes when
+// they're safe to, and leaves out attributes holding their default value.
+// It only applies to the HTML mode.
+//
+// FlushSize is the number of bytes written from a Stream between flushes,
+// which defaults to DefaultFlushSize.
+type Renderer struct {
+	Indent    Indent
+	Mode      Mode
+	Format    Formatter
+	Minify    bool
+	FlushSize int
+}
+
+// NewRenderer returns a Renderer which indents output using NewIndent.
+func NewRenderer() Renderer {
+	return Renderer{Indent: NewIndent()}
+}
+
+// Render writes the View to w, returning the number of bytes written and
+// the first error encountered, at which point rendering stops.
+func (r Renderer) Render(w io.Writer, v View) (int64, error) {
+	return r.RenderContext(context.Background(), w, v)
+}
+
+// render writes the root node using the Renderer's layout.
+func (p *printer) render(e *Node) {
+	switch {
+	case p.minifying():
+		p.node(e, Indent{}, EscapeText)
+	case p.Format != nil:
+		p.format(e, 0, EscapeText)
+	default:
+		p.node(e, p.Indent, EscapeText)
+	}
+}
+
+// printer holds the state of a single call to Render.
+type printer struct {
+	Renderer
+	out *writer
+	// raw when set is the escaping of all text, since it is within a raw
+	// text element.
+	raw *Escaping
+	// scope holds the xml namespaces declared by the enclosing elements.
+	scope scope
+	// wrap when set is written before each attribute in place of a space.
+	wrap string
+	// lines counts the lines started by a Formatter.
+	lines int
+	// pre counts the enclosing pre elements while minifying.
+	pre int
+	// omit when set leaves out the end tag of the next element written.
+	omit bool
+	// ctx resolves ViewFuncs, and rendering stops once it is done.
+	ctx  context.Context
+	done <-chan struct{}
+	// views holds the nodes Contextual nodes resolved to.
+	views map[*Node]*Node
+	// err holds the error of the first custom element used incorrectly,
+	// which is returned once the whole View has been written.
+	err error
+}
+
+// node writes the Node escaping any text with the given escaping context,
+// which is determined by the enclosing element.  Once the writer has
+// failed the remaining nodes are skipped.
+func (p *printer) node(e *Node, in Indent, esc Escaping) {
+	if p.canceled() {
+		return
+	}
+	switch e.Type {
+	case Textual, Markup:
+		if e.CData == "" {
+			return
+		}
+		if in.HasIndent() {
+			p.indent(in)
+		}
+		p.text(e, esc, e.CData)
+		if in.HasIndent() {
+			p.out.byte('\n')
+		}
+	case Attribute:
+		if p.wrap != "" {
+			p.out.str(p.wrap)
+		} else {
+			p.out.byte(' ')
+		}
+		p.out.str(e.Key)
+		if e.IsBool && p.Mode == HTML {
+			return
+		}
+		if p.minifying() {
+			p.minAttribute(e)
+			return
+		}
+		if e.IsBool {
+			p.out.str(`="`)
+			p.text(e, AttrEscaping(e.Key), e.Key)
+			p.out.byte('"')
+			return
+		}
+		p.out.str(`="`)
+		p.text(e, AttrEscaping(e.Key), e.Value)
+		p.out.byte('"')
+	case NodeList:
+		if p.minifying() {
+			p.minified(e, e.Children, esc)
+			return
+		}
+		for _, kid := range e.Children {
+			p.node(kid, in, esc)
+		}
+	case AttributeList:
+		for _, kid := range e.Children {
+			p.node(kid, in, esc)
+		}
+	case Element:
+		p.element(e, in)
+	case Hoisted:
+		// Rendered by a Document as part of its head.
+	case Comment:
+		p.comment(e, in)
+	case Streamed:
+		if p.minifying() {
+			space := false
+			p.stream(e, func(kid *Node) {
+				kids := []*Node{kid}
+				if kid.Type == NodeList {
+					kids = kid.Children
+				}
+				space = p.minifiedRun(e, kids, esc, space)
+			})
+			return
+		}
+		p.stream(e, func(kid *Node) {
+			p.node(kid, in, esc)
+		})
+	case Contextual:
+		p.node(p.resolve(e), in, esc)
+	}
+}
+
+// comment writes a Comment, or the start and end of a conditional Comment
+// around its children.
+func (p *printer) comment(e *Node, in Indent) {
+	if in.HasIndent() {
+		p.indent(in)
+	}
+	if e.Key == "" {
+		p.out.str("<!--")
+		p.out.str(SanitizeComment(e.CData))
+		p.out.str("-->")
+	} else {
+		p.out.str("<!--[if ")
+		p.out.str(SanitizeComment(e.Key))
+		p.out.str("]>")
+		if in.HasIndent() && len(e.Children) > 0 {
+			p.out.byte('\n')
+		}
+		next := in.Incr()
+		for _, kid := range e.Children {
+			p.node(kid, next, EscapeText)
+		}
+		if in.HasIndent() && len(e.Children) > 0 {
+			p.indent(in)
+		}
+		p.out.str("<![endif]-->")
+	}
+	if in.HasIndent() {
+		p.out.byte('\n')
+	}
+}
+
+// element writes the start tag, children and end tag of an Element.
+func (p *printer) element(e *Node, in Indent) {
+	e = p.withContext(e)
+	if in.HasIndent() {
+		p.indent(in)
+	}
+	omit := p.omit
+	p.omit = false
+	defer p.startTag(e)()
+	if p.closeStartTag(e) {
+		p.content(e, in)
+		if !omit {
+			p.endTag(e)
+		}
+	}
+	if in.Level > 0 {
+		p.out.byte('\n')
+	}
+}
+
+// startTag writes the start tag of the element up to its closing '>',
+// returning the func which restores the namespace scope once the element
+// has been written.
+func (p *printer) startTag(e *Node) func() {
+	if err := checkUsage(e); err != nil && p.err == nil {
+		p.err = err
+	}
+	p.out.byte('<')
+	p.out.str(e.Tag)
+	restore := func() {}
+	if p.Mode != HTML {
+		restore = p.declare(e)
+	}
+	for _, att := range e.Attributes {
+		if p.minifying() && isDefault(e, att) {
+			continue
+		}
+		p.node(att, Indent{}, EscapeText)
+	}
+	return restore
+}
+
+// closeStartTag ends the start tag, reporting whether the element goes on
+// to hold content and an end tag.
+func (p *printer) closeStartTag(e *Node) bool {
+	switch {
+	case p.selfClosing(e):
+		if p.Mode == XHTML {
+			p.out.byte(' ')
+		}
+		p.out.str("/>")
+		return false
+	case e.IsVoid && p.Mode == HTML:
+		p.out.byte('>')
+		return false
+	}
+	p.out.byte('>')
+	return true
+}
+
+func (p *printer) endTag(e *Node) {
+	p.out.str("</")
+	p.out.str(e.Tag)
+	p.out.byte('>')
+}
+
+// declare writes the xmlns attributes for the namespaces of the element
+// and its attributes which aren't in scope, returning the func which
+// restores the scope once the element has been written.
+func (p *printer) declare(e *Node) func() {
+	mark := len(p.scope)
+	for _, att := range e.Attributes {
+		if prefix, ok := xmlnsPrefix(att.Key); ok {
+			p.scope = append(p.scope, binding{prefix: prefix, uri: att.Value})
+		}
+	}
+	uri := e.Namespace
+	if uri == "" && p.Mode == XHTML && e.Tag == "html" {
+		uri = XHTMLNamespace
+	}
+	p.need(e.Tag, uri)
+	for _, att := range e.Attributes {
+		// Unprefixed attributes are in no namespace.
+		if prefixOf(att.Key) != "" {
+			p.need(att.Key, att.Namespace)
+		}
+	}
+	return func() {
+		p.scope = p.scope[:mark]
+	}
+}
+
+// need declares the namespace of the name when it isn't in scope.
+func (p *printer) need(name, uri string) {
+	prefix := prefixOf(name)
+	if uri == "" || prefix == "xml" {
+		return
+	}
+	if bound, ok := p.scope.lookup(prefix); ok && bound == uri {
+		return
+	}
+	p.scope = append(p.scope, binding{prefix: prefix, uri: uri})
+	p.node(NewNamespace(prefix, uri).Xmlns().ToNode(), Indent{}, EscapeText)
+}
+
+// selfClosing reports whether the element is written as a single tag
+// ending in "/>", which is the case for void elements in XHTML, any
+// element without children in XML, and svg or MathML elements without
+// children in HTML.
+func (p *printer) selfClosing(e *Node) bool {
+	switch p.Mode {
+	case XHTML:
+		return e.IsVoid || (foreign(e) && len(e.Children) == 0)
+	case XML:
+		return len(e.Children) == 0
+	}
+	return foreign(e) && len(e.Children) == 0
+}
+
+// foreign reports whether the element is svg or MathML, which html parses
+// with xml like rules, so that even script and style hold normal text.
+func foreign(e *Node) bool {
+	return e.Namespace == SVGNamespace || e.Namespace == MathMLNamespace
+}
+
+// content writes the children of an element.  The children of raw text
+// elements are written without indention, since it would become part of
+// their text.
+func (p *printer) content(e *Node, in Indent) {
+	if len(e.Children) == 0 {
+		return
+	}
+	if p.Mode != XML && p.raw == nil && !foreign(e) {
+		switch RawTextKind(e.Tag) {
+		case RawText:
+			esc := TextEscaping(e.Tag)
+			s := Escape(esc, p.inline(e.Children, esc))
+			if p.Mode == XHTML {
+				s = cdata(esc, s)
+			}
+			p.out.str(s)
+			return
+		case EscapableRawText:
+			p.escapableRawText(e.Children)
+			return
+		}
+	}
+	if in.HasIndent() {
+		p.out.byte('\n')
+	}
+	next := in.Incr()
+	esc := p.escaping(e)
+	if p.minifying() {
+		if e.Tag == "pre" || e.Tag == "listing" {
+			p.pre++
+			defer func() { p.pre-- }()
+		}
+		p.minified(e, e.Children, esc)
+		return
+	}
+	for _, kid := range e.Children {
+		p.node(kid, next, esc)
+	}
+	if in.Level > 0 && in.HasIndent() {
+		p.indent(in)
+	}
+}
+
+// cdata wraps the text of a script or style in a CDATA section when it
+// holds characters xml would parse as markup.  The section is commented
+// out in the language of the element, as html parsers don't remove it.
+func cdata(esc Escaping, s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return s
+	}
+	s = strings.ReplaceAll(s, "]]>", "]]]]><![CDATA[>")
+	switch esc {
+	case EscapeScript:
+		return "//<![CDATA[\n" + s + "\n//]]>"
+	case EscapeStyle:
+		return "/*<![CDATA[*/" + s + "/*]]>*/"
+	}
+	return s
+}
+
+// escaping returns the context of the text held by the element.
+func (p *printer) escaping(e *Node) Escaping {
+	switch {
+	case p.raw != nil:
+		return *p.raw
+	case p.Mode == XML, foreign(e):
+		return EscapeText
+	}
+	return TextEscaping(e.Tag)
+}
+
+// escapableRawText writes the children of a textarea or title, which hold
+// only text, so the markup of any other kind of child is escaped as text.
+func (p *printer) escapableRawText(kids []*Node) {
+	for _, kid := range kids {
+		if kid.Type == Textual {
+			p.node(kid, Indent{}, EscapeText)
+			continue
+		}
+		p.out.escaped(EscapeText, p.inline([]*Node{kid}, EscapeText))
+	}
+}
+
+// inline renders the nodes to a string without indention.
+func (p *printer) inline(kids []*Node, esc Escaping) string {
+	var sb strings.Builder
+	sub := &printer{Renderer: p.Renderer, out: newWriter(&sb), raw: &esc, ctx: p.ctx, done: p.done, views: p.views}
+	for _, kid := range kids {
+		sub.node(kid, Indent{}, esc)
+	}
+	if p.err == nil {
+		p.err = sub.err
+	}
+	return sb.String()
+}
+
+// text writes s escaped for the given context unless the Node is Markup
+// or Trusted.  In XML mode characters which xml doesn't allow are dropped.
+func (p *printer) text(e *Node, esc Escaping, s string) {
+	if e.Trusted || e.Type == Markup {
+		p.out.str(s)
+		return
+	}
+	if p.Mode == XML {
+		s = ValidXML(s)
+	}
+	p.out.escaped(esc, s)
+}
+
+func (p *printer) indent(in Indent) {
+	for i := 0; i < in.Level; i++ {
+		p.out.str(in.Tab)
+	}
+}
@@ -0,0 +1,236 @@
+package gel
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// legacyWriteIndented is the original Node.WriteToIndented, which issues
+// a Write for every fragment of a tag, kept to verify that Renderer
+// produces byte identical output and to benchmark against.
+func legacyWriteIndented(e *Node, in Indent, esc Escaping, w io.Writer) {
+	switch e.Type {
+	case Textual, Markup:
+		if in.HasIndent() && e.CData != "" {
+			in.WriteTo(w)
+		}
+		if e.CData != "" {
+			cdata := e.CData
+			if e.Type != Markup && !e.Trusted {
+				cdata = Escape(esc, cdata)
+			}
+			w.Write([]byte(cdata))
+		}
+		if in.HasIndent() && e.CData != "" {
+			w.Write([]byte("\n"))
+		}
+	case Attribute:
+		value := e.Value
+		if !e.Trusted {
+			value = Escape(AttrEscaping(e.Key), value)
+		}
+		w.Write([]byte(" "))
+		w.Write([]byte(e.Key))
+		w.Write([]byte("=\""))
+		w.Write([]byte(value))
+		w.Write([]byte("\""))
+	case AttributeList, NodeList:
+		for _, kid := range e.Children {
+			legacyWriteIndented(kid, in, esc, w)
+		}
+	case Element:
+		if in.HasIndent() {
+			in.WriteTo(w)
+		}
+		w.Write([]byte("<"))
+		w.Write([]byte(e.Tag))
+		for _, att := range e.Attributes {
+			legacyWriteIndented(att, Indent{}, EscapeText, w)
+		}
+		if e.IsVoid {
+			w.Write([]byte("/>"))
+		} else {
+			w.Write([]byte(">"))
+			if len(e.Children) > 0 {
+				if in.HasIndent() {
+					w.Write([]byte("\n"))
+				}
+				next := in.Incr()
+				for _, kid := range e.Children {
+					legacyWriteIndented(kid, next, TextEscaping(e.Tag), w)
+				}
+			}
+		}
+		if in.Level > 0 && in.HasIndent() && len(e.Children) > 0 {
+			in.WriteTo(w)
+		}
+		if !e.IsVoid {
+			w.Write([]byte(fmt.Sprintf("</%s>", e.Tag)))
+		}
+		if in.Level > 0 {
+			w.Write([]byte("\n"))
+		}
+	}
+}
+
+// table builds a page holding a table with the given number of rows.
+func table(rows int) View {
+	body := Tbody()
+	for i := 0; i < rows; i++ {
+		body.ToNode().Add(
+			Tr.Class("row")(
+				Td.Atts("id", fmt.Sprintf("cell-%d", i)).Text("<name> & co"),
+				Td(A.Atts("href", "/items?id=1&x=2").Fmt("item %d", i)),
+				Td(Input.Atts("type", "checkbox", "value", `"v"`)()),
+				Td(Raw("<b>raw</b>"), Text("")),
+			),
+		)
+	}
+	return Html(
+		Head(Title.Text("report"), Script.Text(`var a = "</script>";`)),
+		Body(Table(Thead(Tr(Th.Text("a"), Th.Text("b"))), body)),
+	)
+}
+
+// grid builds a table with the given number of rows which holds only
+// normal elements.
+func grid(rows int) View {
+	body := Tbody()
+	for i := 0; i < rows; i++ {
+		body.ToNode().Add(
+			Tr.Class("row")(
+				Td.Atts("id", fmt.Sprintf("cell-%d", i)).Text("<name> & co"),
+				Td(A.Atts("href", "/items?id=1&x=2").Fmt("item %d", i)),
+				Td(Raw("<b>raw</b>"), Text("")),
+			),
+		)
+	}
+	return Div(Table(Thead(Tr(Th.Text("a"), Th.Text("b"))), body))
+}
+
+func TestRenderer(t *testing.T) {
+
+	// The legacy writer predates the serialization modes, which changed how
+	// void and raw text elements are written, so the trees compared here
+	// avoid them.
+	Convey(`Renderer output should be byte identical to the legacy writer`, t, func() {
+		indents := []Indent{{}, NewIndent(), NewIndent().Incr(), {Level: 0, Inc: 2, Tab: "\t"}}
+		views := []View{grid(3), Frag(Div(), Text("a"), P()), Atts("class", "x")}
+		for _, in := range indents {
+			for _, v := range views {
+				legacy := bytes.NewBuffer([]byte{})
+				legacyWriteIndented(v.ToNode(), in, EscapeText, legacy)
+
+				buf := bytes.NewBuffer([]byte{})
+				n, err := Renderer{Indent: in}.Render(buf, v)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, legacy.Len())
+				So(buf.String(), ShouldEqual, legacy.String())
+			}
+		}
+	})
+
+	Convey(`Rendering to an unbuffered writer should count the bytes flushed`, t, func() {
+		// Wrapping the buffer hides it from the in-memory fast path.
+		var w struct{ bytes.Buffer }
+		expected := table(200).ToNode().String()
+		n, err := Renderer{}.Render(&w, table(200))
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, len(expected))
+		So(w.String(), ShouldEqual, expected)
+	})
+}
+
+func render(r Renderer, v View) string {
+	buf := bytes.NewBuffer([]byte{})
+	r.Render(buf, v)
+	return buf.String()
+}
+
+func TestModes(t *testing.T) {
+	form := Form(Input.Type("checkbox").Checked()(), Br(), Div())
+
+	Convey(`HTML mode should write void elements without a closing slash`, t, func() {
+		So(render(Renderer{}, form), ShouldEqual, `<form><input type="checkbox" checked><br><div></div></form>`)
+	})
+
+	Convey(`XHTML mode should close void elements with " />"`, t, func() {
+		So(render(Renderer{Mode: XHTML}, form), ShouldEqual,
+			`<form><input type="checkbox" checked="checked" /><br /><div></div></form>`)
+	})
+
+	Convey(`XHTML mode should write well formed xml`, t, func() {
+		doc := NewDocument("t", Script.Text("if (a<b && c) {}"), Style.Text(`b::after { content: "<" }`), Script.Text("x]]>y<"))
+		s := render(Renderer{Mode: XHTML}, doc)
+		So(s, ShouldContainSubstring, `<html xmlns="http://www.w3.org/1999/xhtml" lang="en">`)
+		So(s, ShouldContainSubstring, "<script>//<![CDATA[\nif (a<b && c) {}\n//]]></script>")
+		So(s, ShouldContainSubstring, `<style>/*<![CDATA[*/b::after { content: "<" }/*]]>*/</style>`)
+
+		d := xml.NewDecoder(strings.NewReader(s))
+		var text []string
+		for {
+			tok, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			So(err, ShouldBeNil)
+			if data, ok := tok.(xml.CharData); ok {
+				text = append(text, string(data))
+			}
+		}
+		So(strings.Join(text, ""), ShouldContainSubstring, "x]]>y<")
+	})
+
+	Convey(`XML mode should self close every empty element`, t, func() {
+		So(render(Renderer{Mode: XML}, form), ShouldEqual,
+			`<form><input type="checkbox" checked="checked"/><br/><div/></form>`)
+		So(render(Renderer{Mode: XML}, Script.Text("a < b")), ShouldEqual, `<script>a &lt; b</script>`)
+	})
+
+	Convey(`Markup in raw text elements should be written as text`, t, func() {
+		s := render(Renderer{}, Script(Text("var a = 1 < 2;"), B.Text("</script>")))
+		So(s, ShouldEqual, `<script>var a = 1 < 2;<b>\x3C/script></b></script>`)
+	})
+
+	Convey(`Markup in escapable raw text elements should be escaped`, t, func() {
+		s := render(Renderer{}, Textarea(Text("a < b\n"), B.Text("c"), Raw("</textarea>")))
+		So(s, ShouldEqual, "<textarea>a &lt; b\n&lt;b&gt;c&lt;/b&gt;&lt;/textarea&gt;</textarea>")
+	})
+
+	Convey(`Raw text elements should not indent their content`, t, func() {
+		s := render(NewRenderer(), Div(Title.Text("t"), Textarea.Text("x"), P.Text("y")))
+		So(s, ShouldEqual, "<div>\n  <title>t</title>\n  <textarea>x</textarea>\n  <p>\n    y\n  </p>\n</div>")
+	})
+}
+
+func BenchmarkLegacyWriteIndented(b *testing.B) {
+	node := table(1000).ToNode()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		legacyWriteIndented(node, NewIndent(), EscapeText, ioutil.Discard)
+	}
+}
+
+func BenchmarkRenderer(b *testing.B) {
+	node := table(1000).ToNode()
+	r := NewRenderer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r.Render(ioutil.Discard, node)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	node := table(1000).ToNode()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = node.String()
+	}
+}
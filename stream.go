@@ -0,0 +1,89 @@
+package gel
+
+import "context"
+
+// DefaultFlushSize is the number of bytes a Renderer writes from a Stream
+// between flushes when its FlushSize is 0.
+const DefaultFlushSize = 32 << 10
+
+// Stream creates a View whose children are pulled from next one at a time
+// while rendering, until next returns nil, so that a large document never
+// has to be held in memory.  When rendering to a Flusher, like an
+// http.ResponseWriter, the output before the Stream is flushed when it is
+// reached, so the browser can start on the head of the page, and the
+// output is flushed again every FlushSize bytes.
+//
+// A Stream can only be rendered once, and Documents don't hoist views to
+// their head from within it.
+func Stream(next func() View) View {
+	node := &Node{
+		Type: Streamed,
+		Pull: func(context.Context) View {
+			return next()
+		},
+	}
+	return node
+}
+
+// StreamChan creates a Stream of the views received from the channel
+// until it is closed, or until the context of the render is done.
+//
+// The renderer stops receiving once rendering stops early, so the
+// producer must stop sending once the context given to RenderContext is
+// done, and the caller must cancel that context when RenderContext
+// returns with an error, as net/http does for the context of a request
+// once its handler returns.
+func StreamChan(views <-chan View) View {
+	node := &Node{
+		Type: Streamed,
+		Pull: func(ctx context.Context) View {
+			select {
+			case v := <-views:
+				return v
+			case <-ctx.Done():
+				return nil
+			}
+		},
+	}
+	return node
+}
+
+// StreamSlice creates a Stream which renders each of the items with fn,
+// building the View of an item only once it is reached.
+func StreamSlice(n int, fn func(i int) View) View {
+	i := 0
+	return Stream(func() View {
+		if i >= n {
+			return nil
+		}
+		i++
+		return fn(i - 1)
+	})
+}
+
+// stream pulls the views of a Streamed node, passing each to write and
+// flushing the output as it goes.
+func (p *printer) stream(e *Node, write func(*Node)) {
+	if e.Pull == nil {
+		return
+	}
+	p.out.flush()
+	flushed := p.out.n
+	size := int64(p.FlushSize)
+	if size <= 0 {
+		size = DefaultFlushSize
+	}
+	views := p.views
+	defer func() { p.views = views }()
+	for v := e.Pull(p.ctx); v != nil && !p.canceled(); v = e.Pull(p.ctx) {
+		// The ViewFuncs of each view are forgotten once it is written.
+		p.views = map[*Node]*Node{}
+		write(v.ToNode())
+		if p.out.n-flushed >= size {
+			p.out.flush()
+			flushed = p.out.n
+		}
+	}
+	// A Pull ended by the context fails the output with its error.
+	p.canceled()
+}
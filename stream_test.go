@@ -0,0 +1,130 @@
+package gel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// flushRecorder records the output it holds each time it is flushed.
+type flushRecorder struct {
+	buf     bytes.Buffer
+	flushes []string
+}
+
+func (f *flushRecorder) Write(b []byte) (int, error) {
+	return f.buf.Write(b)
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushes = append(f.flushes, f.buf.String())
+}
+
+func rows(n int) View {
+	return StreamSlice(n, func(i int) View {
+		return Tr(Td.Fmt("row %d", i))
+	})
+}
+
+func TestStream(t *testing.T) {
+
+	Convey(`A Stream should render the views it pulls in order`, t, func() {
+		s := Table(Tbody(rows(3))).ToNode().String()
+		So(s, ShouldEqual, `<table><tbody><tr><td>row 0</td></tr><tr><td>row 1</td></tr><tr><td>row 2</td></tr></tbody></table>`)
+	})
+
+	Convey(`A Stream over a channel should render until it is closed`, t, func() {
+		ch := make(chan View)
+		go func() {
+			for i := 0; i < 3; i++ {
+				ch <- Li.Fmt("%d", i)
+			}
+			close(ch)
+		}()
+		So(Ul(StreamChan(ch)).ToNode().String(), ShouldEqual, `<ul><li>0</li><li>1</li><li>2</li></ul>`)
+	})
+
+	Convey(`A Stream over a channel should stop when the render is canceled`, t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(chan View)
+		stopped := make(chan bool)
+		go func() {
+			for i := 0; ; i++ {
+				if i == 2 {
+					cancel()
+				}
+				select {
+				case ch <- Li.Fmt("%d", i):
+				case <-ctx.Done():
+					close(stopped)
+					return
+				}
+			}
+		}()
+		buf := bytes.NewBuffer([]byte{})
+		_, err := Renderer{}.RenderContext(ctx, buf, Ul(StreamChan(ch)))
+		So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		So(buf.String(), ShouldStartWith, `<ul><li>0</li><li>1</li>`)
+		So(<-stopped, ShouldBeFalse)
+	})
+
+	Convey(`Views should be built only as they are pulled`, t, func() {
+		built := 0
+		w := &flushRecorder{}
+		v := Div(Stream(func() View {
+			if built == 2 {
+				return nil
+			}
+			built++
+			return P.Fmt("%d", built)
+		}))
+		So(built, ShouldEqual, 0)
+		Renderer{}.Render(w, v)
+		So(built, ShouldEqual, 2)
+	})
+
+	Convey(`Output before a Stream should be flushed when it is reached`, t, func() {
+		w := &flushRecorder{}
+		doc := NewDocument("Report", Table(Tbody(rows(2))))
+		_, err := Renderer{}.Render(w, doc)
+		So(err, ShouldBeNil)
+		So(len(w.flushes), ShouldBeGreaterThan, 0)
+		So(w.flushes[0], ShouldEndWith, `<body><table><tbody>`)
+		So(w.flushes[0], ShouldContainSubstring, `<title>Report</title>`)
+	})
+
+	Convey(`Output should be flushed every FlushSize bytes`, t, func() {
+		w := &flushRecorder{}
+		r := Renderer{FlushSize: 1000}
+		n, err := r.Render(w, Table(rows(1000)))
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, w.buf.Len())
+		So(len(w.flushes), ShouldBeBetween, 20, 40)
+		row := len(fmt.Sprint(Tr(Td.Fmt("row %d", 999))))
+		last := len(w.flushes[0])
+		for _, f := range w.flushes[1:] {
+			So(len(f)-last, ShouldBeLessThan, 1000+row)
+			last = len(f)
+		}
+		So(strings.HasSuffix(w.buf.String(), `<tr><td>row 999</td></tr></table>`), ShouldBeTrue)
+	})
+
+	Convey(`Streams should be laid out by a Formatter as they are pulled`, t, func() {
+		s := render(Renderer{Format: NewPretty()}, Ul(StreamSlice(2, func(i int) View { return Li.Fmt("%d", i) })))
+		So(s, ShouldEqual, "<ul>\n  <li>0</li>\n  <li>1</li>\n</ul>")
+	})
+
+	Convey(`Streams nested in inline elements should be rendered once by a Formatter`, t, func() {
+		words := func() View { return StreamSlice(30, func(i int) View { return Text(fmt.Sprintf("w%d ", i)) }) }
+		for _, v := range []View{Div(Span(words())), Div(P(Span(words()))), Div(Span(Em(words())))} {
+			s := render(Renderer{Format: NewPretty()}, v)
+			So(s, ShouldContainSubstring, "w0")
+			So(s, ShouldContainSubstring, "w29")
+		}
+	})
+}
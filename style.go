@@ -0,0 +1,139 @@
+package gel
+
+import (
+	"strings"
+)
+
+// UnsafeCSS replaces a css value which could run script or load bindings,
+// like expression(...) or a javascript: url.
+const UnsafeCSS = "ZgelZ"
+
+// unsafeCSS are the patterns of css values which are never allowed.
+var unsafeCSS = []string{
+	"expression(",
+	"javascript:",
+	"vbscript:",
+	"-moz-binding",
+	"behavior:",
+}
+
+// Styles is an ordered list of css declarations which renders as a single
+// style attribute.  Setting a property again replaces its value in place,
+// and the style attribute merges with any other style attribute added to
+// the same Element.
+type Styles struct {
+	decls declarations
+}
+
+// NewStyles creates Styles from pairs of property and value.
+func NewStyles(pairs ...string) *Styles {
+	s := &Styles{}
+	for i := 0; (i + 1) < len(pairs); i += 2 {
+		s.Set(pairs[i], pairs[i+1])
+	}
+	return s
+}
+
+// Set adds the declaration, or replaces the value of a property already
+// set.  A property name which isn't a css identifier is ignored, and the
+// value is escaped so it can't end the declaration.
+func (s *Styles) Set(prop, value string) *Styles {
+	prop = strings.TrimSpace(prop)
+	if !isCSSIdent(prop) {
+		return s
+	}
+	s.decls = s.decls.set(prop, escapeCSS(strings.TrimSpace(value)))
+	return s
+}
+
+// Get returns the value of the property, or "" if it isn't set.
+func (s *Styles) Get(prop string) string {
+	for _, d := range s.decls {
+		if strings.EqualFold(d.prop, prop) {
+			return d.value
+		}
+	}
+	return ""
+}
+
+// Remove deletes the declaration of the property.
+func (s *Styles) Remove(prop string) *Styles {
+	for i, d := range s.decls {
+		if strings.EqualFold(d.prop, prop) {
+			s.decls = append(s.decls[:i:i], s.decls[i+1:]...)
+			break
+		}
+	}
+	return s
+}
+
+// Len returns the number of declarations.
+func (s *Styles) Len() int {
+	return len(s.decls)
+}
+
+// String renders the declarations as the value of a style attribute.
+func (s *Styles) String() string {
+	return s.decls.String()
+}
+
+// ToNode implements the View interface producing the style Attribute, or
+// an empty AttributeList when there are no declarations.
+func (s *Styles) ToNode() *Node {
+	if s.Len() == 0 {
+		return Atts().ToNode()
+	}
+	return Att("style", s.String()).ToNode()
+}
+
+// Style creates a tag with the given pairs of css property and value.
+func (t Tag) Style(pairs ...string) Tag {
+	return t.With(NewStyles(pairs...))
+}
+
+// escapeCSS replaces values that could run script with UnsafeCSS, and
+// otherwise escapes the characters that could end the declaration or
+// the style attribute as css hex escapes.
+func escapeCSS(value string) string {
+	lower := strings.ToLower(value)
+	for _, bad := range unsafeCSS {
+		if strings.Contains(lower, bad) {
+			return UnsafeCSS
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case ';', '{', '}', '<', '>', '\\', '\n', '\r', '\f':
+			b.WriteByte('\\')
+			b.WriteString(hex(c))
+			b.WriteByte(' ')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func hex(c byte) string {
+	const digits = "0123456789abcdef"
+	if c < 16 {
+		return digits[c : c+1]
+	}
+	return string([]byte{digits[c>>4], digits[c&15]})
+}
+
+// isCSSIdent reports whether the name is a css property name, including
+// custom properties like --main-color.
+func isCSSIdent(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !isLetter(c) && !('0' <= c && c <= '9') && c != '-' && c != '_' {
+			return false
+		}
+	}
+	return true
+}
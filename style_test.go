@@ -0,0 +1,52 @@
+package gel
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStyles(t *testing.T) {
+
+	Convey(`Styles should render declarations in the order they are set`, t, func() {
+		s := NewStyles("color", "red", "margin", "0")
+		s.Set("padding", "1em").Set("COLOR", "blue")
+		So(s.String(), ShouldEqual, "color: blue; margin: 0; padding: 1em")
+		So(s.Get("color"), ShouldEqual, "blue")
+		So(s.Remove("margin").Len(), ShouldEqual, 2)
+	})
+
+	Convey(`Tag.Style should add the style attribute`, t, func() {
+		d := Div.Style("display", "flex", "--gap", "4px").Text("x").ToNode().String()
+		So(d, ShouldEqual, `<div style="display: flex; --gap: 4px">x</div>`)
+	})
+
+	Convey(`Styles used as children should merge with other style attributes`, t, func() {
+		d := Div.Style("color", "red")(
+			NewStyles("margin", "0"),
+			Att("style", "color: green"),
+		).ToNode()
+		So(d.Attributes, ShouldHaveLength, 1)
+		So(d.String(), ShouldEqual, `<div style="color: green; margin: 0"></div>`)
+	})
+
+	Convey(`Values should be escaped so they can't add declarations`, t, func() {
+		s := NewStyles("color", `red; background: url(x)`, "font-family", `"Open Sans"`)
+		So(s.String(), ShouldEqual, `color: red\3b  background: url(x); font-family: "Open Sans"`)
+		So(Div(s).ToNode().String(), ShouldEqual, `<div style="color: red\3b  background: url(x); font-family: &#34;Open Sans&#34;"></div>`)
+
+		s = NewStyles("font-family", `a\`, "color", "red")
+		So(s.String(), ShouldEqual, `font-family: a\5c ; color: red`)
+	})
+
+	Convey(`Values which could run script should be replaced`, t, func() {
+		s := NewStyles("width", "expression(alert(1))", "background", "url(JavaScript:alert(1))")
+		So(s.String(), ShouldEqual, "width: ZgelZ; background: ZgelZ")
+	})
+
+	Convey(`Invalid property names and empty styles should be dropped`, t, func() {
+		s := NewStyles("color:red;x", "1")
+		So(s.Len(), ShouldEqual, 0)
+		So(Div(s).ToNode().String(), ShouldEqual, `<div></div>`)
+	})
+}
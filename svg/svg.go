@@ -0,0 +1,28 @@
+// Package svg provides the Tags of the svg elements, along with the
+// attributes whose names are case sensitive, for building inline svg
+// images which compose with the Views of package gel.
+//
+// The elements are in the svg Namespace, so a Renderer in the XML or XHTML
+// mode declares it on the outermost svg element, while in the HTML mode,
+// where the html parser places svg in its namespace, empty elements are
+// written self closed as in <path d="M0 0"/>.
+package svg
+
+import (
+	"github.com/lcaballero/gel"
+)
+
+//go:generate go run ../cmd/gentags -in svg.go.tpl -out svg_gen.go
+
+var (
+	// NS is the default svg Namespace of the elements.
+	NS = gel.NewNamespace("", gel.SVGNamespace)
+	// XLink is the namespace of the xlink:href attribute used by svg 1.1.
+	XLink = gel.NewNamespace("xlink", gel.XLinkNamespace)
+)
+
+// Href adds the xlink:href attribute, which older renderers require in
+// place of href.
+func Href(value string) gel.View {
+	return XLink.Att("href", value)
+}
@@ -0,0 +1,145 @@
+---
+# Elements of svg 2, named with their identifier in camel case.
+elements:
+  - A
+  - Animate
+  - AnimateMotion
+  - AnimateTransform
+  - Circle
+  - ClipPath
+  - Defs
+  - Desc
+  - Ellipse
+  - FeBlend
+  - FeColorMatrix
+  - FeComponentTransfer
+  - FeComposite
+  - FeConvolveMatrix
+  - FeDiffuseLighting
+  - FeDisplacementMap
+  - FeDistantLight
+  - FeDropShadow
+  - FeFlood
+  - FeFuncA
+  - FeFuncB
+  - FeFuncG
+  - FeFuncR
+  - FeGaussianBlur
+  - FeImage
+  - FeMerge
+  - FeMergeNode
+  - FeMorphology
+  - FeOffset
+  - FePointLight
+  - FeSpecularLighting
+  - FeSpotLight
+  - FeTile
+  - FeTurbulence
+  - Filter
+  - ForeignObject
+  - G
+  - Image
+  - Line
+  - LinearGradient
+  - Marker
+  - Mask
+  - Metadata
+  - Mpath
+  - Path
+  - Pattern
+  - Polygon
+  - Polyline
+  - RadialGradient
+  - Rect
+  - Script
+  - Set
+  - Stop
+  - Style
+  - Svg
+  - Switch
+  - Symbol
+  - Text
+  - TextPath
+  - Title
+  - Tspan
+  - Use
+  - View
+# Attributes whose names have upper case letters, which html would
+# otherwise lower case.  The rest are written with gel.Att.
+attrs:
+  - AttributeName
+  - BaseFrequency
+  - CalcMode
+  - ClipPathUnits
+  - DiffuseConstant
+  - EdgeMode
+  - FilterUnits
+  - GradientTransform
+  - GradientUnits
+  - KernelMatrix
+  - KernelUnitLength
+  - KeyPoints
+  - KeySplines
+  - KeyTimes
+  - LengthAdjust
+  - LimitingConeAngle
+  - MarkerHeight
+  - MarkerUnits
+  - MarkerWidth
+  - MaskContentUnits
+  - MaskUnits
+  - NumOctaves
+  - PathLength
+  - PatternContentUnits
+  - PatternTransform
+  - PatternUnits
+  - PointsAtX
+  - PointsAtY
+  - PointsAtZ
+  - PreserveAlpha
+  - PreserveAspectRatio
+  - PrimitiveUnits
+  - RefX
+  - RefY
+  - RepeatCount
+  - RepeatDur
+  - SpecularConstant
+  - SpecularExponent
+  - SpreadMethod
+  - StartOffset
+  - StdDeviation
+  - StitchTiles
+  - SurfaceScale
+  - SystemLanguage
+  - TableValues
+  - TargetX
+  - TargetY
+  - TextLength
+  - ViewBox
+  - XChannelSelector
+  - YChannelSelector
+---
+{{ .GEN_TAGLINE }}
+
+package svg
+
+import (
+  "github.com/lcaballero/gel"
+)
+
+var (
+  {{ range .elements }}{{ ident . }} gel.Tag = NS.E("{{ camel . }}")
+  {{ end }}
+)
+
+// Tags maps each element name to its Tag.
+var Tags = map[string]gel.Tag{
+  {{ range .elements }}"{{ camel . }}": {{ ident . }},
+  {{ end }}
+}
+{{ range .attrs }}
+// {{ ident . }} creates the {{ camel . }} attribute with the given value.
+func {{ ident . }}(value string) gel.View {
+  return gel.Att("{{ camel . }}", value)
+}
+{{ end }}
@@ -0,0 +1,395 @@
+// Code generated by gentags from svg.go.tpl; DO NOT EDIT.
+
+package svg
+
+import (
+	"github.com/lcaballero/gel"
+)
+
+var (
+	A                   gel.Tag = NS.E("a")
+	Animate             gel.Tag = NS.E("animate")
+	AnimateMotion       gel.Tag = NS.E("animateMotion")
+	AnimateTransform    gel.Tag = NS.E("animateTransform")
+	Circle              gel.Tag = NS.E("circle")
+	ClipPath            gel.Tag = NS.E("clipPath")
+	Defs                gel.Tag = NS.E("defs")
+	Desc                gel.Tag = NS.E("desc")
+	Ellipse             gel.Tag = NS.E("ellipse")
+	FeBlend             gel.Tag = NS.E("feBlend")
+	FeColorMatrix       gel.Tag = NS.E("feColorMatrix")
+	FeComponentTransfer gel.Tag = NS.E("feComponentTransfer")
+	FeComposite         gel.Tag = NS.E("feComposite")
+	FeConvolveMatrix    gel.Tag = NS.E("feConvolveMatrix")
+	FeDiffuseLighting   gel.Tag = NS.E("feDiffuseLighting")
+	FeDisplacementMap   gel.Tag = NS.E("feDisplacementMap")
+	FeDistantLight      gel.Tag = NS.E("feDistantLight")
+	FeDropShadow        gel.Tag = NS.E("feDropShadow")
+	FeFlood             gel.Tag = NS.E("feFlood")
+	FeFuncA             gel.Tag = NS.E("feFuncA")
+	FeFuncB             gel.Tag = NS.E("feFuncB")
+	FeFuncG             gel.Tag = NS.E("feFuncG")
+	FeFuncR             gel.Tag = NS.E("feFuncR")
+	FeGaussianBlur      gel.Tag = NS.E("feGaussianBlur")
+	FeImage             gel.Tag = NS.E("feImage")
+	FeMerge             gel.Tag = NS.E("feMerge")
+	FeMergeNode         gel.Tag = NS.E("feMergeNode")
+	FeMorphology        gel.Tag = NS.E("feMorphology")
+	FeOffset            gel.Tag = NS.E("feOffset")
+	FePointLight        gel.Tag = NS.E("fePointLight")
+	FeSpecularLighting  gel.Tag = NS.E("feSpecularLighting")
+	FeSpotLight         gel.Tag = NS.E("feSpotLight")
+	FeTile              gel.Tag = NS.E("feTile")
+	FeTurbulence        gel.Tag = NS.E("feTurbulence")
+	Filter              gel.Tag = NS.E("filter")
+	ForeignObject       gel.Tag = NS.E("foreignObject")
+	G                   gel.Tag = NS.E("g")
+	Image               gel.Tag = NS.E("image")
+	Line                gel.Tag = NS.E("line")
+	LinearGradient      gel.Tag = NS.E("linearGradient")
+	Marker              gel.Tag = NS.E("marker")
+	Mask                gel.Tag = NS.E("mask")
+	Metadata            gel.Tag = NS.E("metadata")
+	Mpath               gel.Tag = NS.E("mpath")
+	Path                gel.Tag = NS.E("path")
+	Pattern             gel.Tag = NS.E("pattern")
+	Polygon             gel.Tag = NS.E("polygon")
+	Polyline            gel.Tag = NS.E("polyline")
+	RadialGradient      gel.Tag = NS.E("radialGradient")
+	Rect                gel.Tag = NS.E("rect")
+	Script              gel.Tag = NS.E("script")
+	Set                 gel.Tag = NS.E("set")
+	Stop                gel.Tag = NS.E("stop")
+	Style               gel.Tag = NS.E("style")
+	Svg                 gel.Tag = NS.E("svg")
+	Switch              gel.Tag = NS.E("switch")
+	Symbol              gel.Tag = NS.E("symbol")
+	Text                gel.Tag = NS.E("text")
+	TextPath            gel.Tag = NS.E("textPath")
+	Title               gel.Tag = NS.E("title")
+	Tspan               gel.Tag = NS.E("tspan")
+	Use                 gel.Tag = NS.E("use")
+	View                gel.Tag = NS.E("view")
+)
+
+// Tags maps each element name to its Tag.
+var Tags = map[string]gel.Tag{
+	"a":                   A,
+	"animate":             Animate,
+	"animateMotion":       AnimateMotion,
+	"animateTransform":    AnimateTransform,
+	"circle":              Circle,
+	"clipPath":            ClipPath,
+	"defs":                Defs,
+	"desc":                Desc,
+	"ellipse":             Ellipse,
+	"feBlend":             FeBlend,
+	"feColorMatrix":       FeColorMatrix,
+	"feComponentTransfer": FeComponentTransfer,
+	"feComposite":         FeComposite,
+	"feConvolveMatrix":    FeConvolveMatrix,
+	"feDiffuseLighting":   FeDiffuseLighting,
+	"feDisplacementMap":   FeDisplacementMap,
+	"feDistantLight":      FeDistantLight,
+	"feDropShadow":        FeDropShadow,
+	"feFlood":             FeFlood,
+	"feFuncA":             FeFuncA,
+	"feFuncB":             FeFuncB,
+	"feFuncG":             FeFuncG,
+	"feFuncR":             FeFuncR,
+	"feGaussianBlur":      FeGaussianBlur,
+	"feImage":             FeImage,
+	"feMerge":             FeMerge,
+	"feMergeNode":         FeMergeNode,
+	"feMorphology":        FeMorphology,
+	"feOffset":            FeOffset,
+	"fePointLight":        FePointLight,
+	"feSpecularLighting":  FeSpecularLighting,
+	"feSpotLight":         FeSpotLight,
+	"feTile":              FeTile,
+	"feTurbulence":        FeTurbulence,
+	"filter":              Filter,
+	"foreignObject":       ForeignObject,
+	"g":                   G,
+	"image":               Image,
+	"line":                Line,
+	"linearGradient":      LinearGradient,
+	"marker":              Marker,
+	"mask":                Mask,
+	"metadata":            Metadata,
+	"mpath":               Mpath,
+	"path":                Path,
+	"pattern":             Pattern,
+	"polygon":             Polygon,
+	"polyline":            Polyline,
+	"radialGradient":      RadialGradient,
+	"rect":                Rect,
+	"script":              Script,
+	"set":                 Set,
+	"stop":                Stop,
+	"style":               Style,
+	"svg":                 Svg,
+	"switch":              Switch,
+	"symbol":              Symbol,
+	"text":                Text,
+	"textPath":            TextPath,
+	"title":               Title,
+	"tspan":               Tspan,
+	"use":                 Use,
+	"view":                View,
+}
+
+// AttributeName creates the attributeName attribute with the given value.
+func AttributeName(value string) gel.View {
+	return gel.Att("attributeName", value)
+}
+
+// BaseFrequency creates the baseFrequency attribute with the given value.
+func BaseFrequency(value string) gel.View {
+	return gel.Att("baseFrequency", value)
+}
+
+// CalcMode creates the calcMode attribute with the given value.
+func CalcMode(value string) gel.View {
+	return gel.Att("calcMode", value)
+}
+
+// ClipPathUnits creates the clipPathUnits attribute with the given value.
+func ClipPathUnits(value string) gel.View {
+	return gel.Att("clipPathUnits", value)
+}
+
+// DiffuseConstant creates the diffuseConstant attribute with the given value.
+func DiffuseConstant(value string) gel.View {
+	return gel.Att("diffuseConstant", value)
+}
+
+// EdgeMode creates the edgeMode attribute with the given value.
+func EdgeMode(value string) gel.View {
+	return gel.Att("edgeMode", value)
+}
+
+// FilterUnits creates the filterUnits attribute with the given value.
+func FilterUnits(value string) gel.View {
+	return gel.Att("filterUnits", value)
+}
+
+// GradientTransform creates the gradientTransform attribute with the given value.
+func GradientTransform(value string) gel.View {
+	return gel.Att("gradientTransform", value)
+}
+
+// GradientUnits creates the gradientUnits attribute with the given value.
+func GradientUnits(value string) gel.View {
+	return gel.Att("gradientUnits", value)
+}
+
+// KernelMatrix creates the kernelMatrix attribute with the given value.
+func KernelMatrix(value string) gel.View {
+	return gel.Att("kernelMatrix", value)
+}
+
+// KernelUnitLength creates the kernelUnitLength attribute with the given value.
+func KernelUnitLength(value string) gel.View {
+	return gel.Att("kernelUnitLength", value)
+}
+
+// KeyPoints creates the keyPoints attribute with the given value.
+func KeyPoints(value string) gel.View {
+	return gel.Att("keyPoints", value)
+}
+
+// KeySplines creates the keySplines attribute with the given value.
+func KeySplines(value string) gel.View {
+	return gel.Att("keySplines", value)
+}
+
+// KeyTimes creates the keyTimes attribute with the given value.
+func KeyTimes(value string) gel.View {
+	return gel.Att("keyTimes", value)
+}
+
+// LengthAdjust creates the lengthAdjust attribute with the given value.
+func LengthAdjust(value string) gel.View {
+	return gel.Att("lengthAdjust", value)
+}
+
+// LimitingConeAngle creates the limitingConeAngle attribute with the given value.
+func LimitingConeAngle(value string) gel.View {
+	return gel.Att("limitingConeAngle", value)
+}
+
+// MarkerHeight creates the markerHeight attribute with the given value.
+func MarkerHeight(value string) gel.View {
+	return gel.Att("markerHeight", value)
+}
+
+// MarkerUnits creates the markerUnits attribute with the given value.
+func MarkerUnits(value string) gel.View {
+	return gel.Att("markerUnits", value)
+}
+
+// MarkerWidth creates the markerWidth attribute with the given value.
+func MarkerWidth(value string) gel.View {
+	return gel.Att("markerWidth", value)
+}
+
+// MaskContentUnits creates the maskContentUnits attribute with the given value.
+func MaskContentUnits(value string) gel.View {
+	return gel.Att("maskContentUnits", value)
+}
+
+// MaskUnits creates the maskUnits attribute with the given value.
+func MaskUnits(value string) gel.View {
+	return gel.Att("maskUnits", value)
+}
+
+// NumOctaves creates the numOctaves attribute with the given value.
+func NumOctaves(value string) gel.View {
+	return gel.Att("numOctaves", value)
+}
+
+// PathLength creates the pathLength attribute with the given value.
+func PathLength(value string) gel.View {
+	return gel.Att("pathLength", value)
+}
+
+// PatternContentUnits creates the patternContentUnits attribute with the given value.
+func PatternContentUnits(value string) gel.View {
+	return gel.Att("patternContentUnits", value)
+}
+
+// PatternTransform creates the patternTransform attribute with the given value.
+func PatternTransform(value string) gel.View {
+	return gel.Att("patternTransform", value)
+}
+
+// PatternUnits creates the patternUnits attribute with the given value.
+func PatternUnits(value string) gel.View {
+	return gel.Att("patternUnits", value)
+}
+
+// PointsAtX creates the pointsAtX attribute with the given value.
+func PointsAtX(value string) gel.View {
+	return gel.Att("pointsAtX", value)
+}
+
+// PointsAtY creates the pointsAtY attribute with the given value.
+func PointsAtY(value string) gel.View {
+	return gel.Att("pointsAtY", value)
+}
+
+// PointsAtZ creates the pointsAtZ attribute with the given value.
+func PointsAtZ(value string) gel.View {
+	return gel.Att("pointsAtZ", value)
+}
+
+// PreserveAlpha creates the preserveAlpha attribute with the given value.
+func PreserveAlpha(value string) gel.View {
+	return gel.Att("preserveAlpha", value)
+}
+
+// PreserveAspectRatio creates the preserveAspectRatio attribute with the given value.
+func PreserveAspectRatio(value string) gel.View {
+	return gel.Att("preserveAspectRatio", value)
+}
+
+// PrimitiveUnits creates the primitiveUnits attribute with the given value.
+func PrimitiveUnits(value string) gel.View {
+	return gel.Att("primitiveUnits", value)
+}
+
+// RefX creates the refX attribute with the given value.
+func RefX(value string) gel.View {
+	return gel.Att("refX", value)
+}
+
+// RefY creates the refY attribute with the given value.
+func RefY(value string) gel.View {
+	return gel.Att("refY", value)
+}
+
+// RepeatCount creates the repeatCount attribute with the given value.
+func RepeatCount(value string) gel.View {
+	return gel.Att("repeatCount", value)
+}
+
+// RepeatDur creates the repeatDur attribute with the given value.
+func RepeatDur(value string) gel.View {
+	return gel.Att("repeatDur", value)
+}
+
+// SpecularConstant creates the specularConstant attribute with the given value.
+func SpecularConstant(value string) gel.View {
+	return gel.Att("specularConstant", value)
+}
+
+// SpecularExponent creates the specularExponent attribute with the given value.
+func SpecularExponent(value string) gel.View {
+	return gel.Att("specularExponent", value)
+}
+
+// SpreadMethod creates the spreadMethod attribute with the given value.
+func SpreadMethod(value string) gel.View {
+	return gel.Att("spreadMethod", value)
+}
+
+// StartOffset creates the startOffset attribute with the given value.
+func StartOffset(value string) gel.View {
+	return gel.Att("startOffset", value)
+}
+
+// StdDeviation creates the stdDeviation attribute with the given value.
+func StdDeviation(value string) gel.View {
+	return gel.Att("stdDeviation", value)
+}
+
+// StitchTiles creates the stitchTiles attribute with the given value.
+func StitchTiles(value string) gel.View {
+	return gel.Att("stitchTiles", value)
+}
+
+// SurfaceScale creates the surfaceScale attribute with the given value.
+func SurfaceScale(value string) gel.View {
+	return gel.Att("surfaceScale", value)
+}
+
+// SystemLanguage creates the systemLanguage attribute with the given value.
+func SystemLanguage(value string) gel.View {
+	return gel.Att("systemLanguage", value)
+}
+
+// TableValues creates the tableValues attribute with the given value.
+func TableValues(value string) gel.View {
+	return gel.Att("tableValues", value)
+}
+
+// TargetX creates the targetX attribute with the given value.
+func TargetX(value string) gel.View {
+	return gel.Att("targetX", value)
+}
+
+// TargetY creates the targetY attribute with the given value.
+func TargetY(value string) gel.View {
+	return gel.Att("targetY", value)
+}
+
+// TextLength creates the textLength attribute with the given value.
+func TextLength(value string) gel.View {
+	return gel.Att("textLength", value)
+}
+
+// ViewBox creates the viewBox attribute with the given value.
+func ViewBox(value string) gel.View {
+	return gel.Att("viewBox", value)
+}
+
+// XChannelSelector creates the xChannelSelector attribute with the given value.
+func XChannelSelector(value string) gel.View {
+	return gel.Att("xChannelSelector", value)
+}
+
+// YChannelSelector creates the yChannelSelector attribute with the given value.
+func YChannelSelector(value string) gel.View {
+	return gel.Att("yChannelSelector", value)
+}
@@ -0,0 +1,53 @@
+package svg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lcaballero/gel"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func render(mode gel.Mode, v gel.View) string {
+	buf := bytes.NewBuffer([]byte{})
+	gel.Renderer{Mode: mode}.Render(buf, v)
+	return buf.String()
+}
+
+func icon() gel.View {
+	return Svg(
+		ViewBox("0 0 24 24"),
+		Defs(
+			LinearGradient.With(gel.Att("id", "fade"), GradientUnits("userSpaceOnUse"))(
+				Stop(gel.Atts("offset", "0", "stop-color", "#fff")),
+			),
+		),
+		Path(gel.Att("d", "M0 0L24 24"), gel.Att("fill", "url(#fade)")),
+	)
+}
+
+func TestSvg(t *testing.T) {
+
+	Convey(`Element and attribute names should keep their case`, t, func() {
+		So(render(gel.HTML, icon()), ShouldEqual, `<svg viewBox="0 0 24 24"><defs>`+
+			`<linearGradient id="fade" gradientUnits="userSpaceOnUse"><stop offset="0" stop-color="#fff"/></linearGradient>`+
+			`</defs><path d="M0 0L24 24" fill="url(#fade)"/></svg>`)
+		So(Tags["feGaussianBlur"], ShouldNotBeNil)
+	})
+
+	Convey(`Svg should compose with html elements`, t, func() {
+		s := render(gel.HTML, gel.Div.Class("icon")(Svg(Circle(gel.Atts("r", "4"))), gel.Br()))
+		So(s, ShouldEqual, `<div class="icon"><svg><circle r="4"/></svg><br></div>`)
+	})
+
+	Convey(`XML mode should declare the svg namespace once on the outermost element`, t, func() {
+		s := render(gel.XML, Svg(G(Use(Href("#a")))))
+		So(s, ShouldEqual, `<svg xmlns="http://www.w3.org/2000/svg"><g>`+
+			`<use xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="#a"/></g></svg>`)
+	})
+
+	Convey(`Svg script and style should hold escaped text rather than raw text`, t, func() {
+		s := render(gel.HTML, Svg(Style.Text("a > b {}")))
+		So(s, ShouldEqual, `<svg><style>a &gt; b {}</style></svg>`)
+	})
+}
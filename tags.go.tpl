@@ -21,7 +21,9 @@ normal:
   - Datalist
   - Dd
   - Del
+  - Details
   - Dfn
+  - Dialog
   - Div
   - Dl
   - Dt
@@ -39,6 +41,7 @@ normal:
   - H6
   - Head
   - Header
+  - Hgroup
   - Html
   - I
   - Iframe
@@ -50,6 +53,7 @@ normal:
   - Main
   - Map
   - Mark
+  - Menu
   - Meter
   - Nav
   - Noscript
@@ -59,24 +63,26 @@ normal:
   - Option
   - Output
   - P
+  - Picture
   - Pre
   - Progress
   - Q
-  - Rb
   - Rp
   - Rt
-  - Rtc
   - Ruby
   - S
   - Samp
   - Script
+  - Search
   - Section
   - Select
+  - Slot
   - Small
   - Span
   - Strong
   - Style
   - Sub
+  - Summary
   - Sup
   - Table
   - Tbody
@@ -102,17 +108,23 @@ void:
   - Hr
   - Img
   - Input
-  - Keygen
   - Link
   - Meta
-  - Param
   - Source
   - Track
   - Wbr
+# Elements removed from the living standard, kept for compatibility.
+obsolete:
+  - Rb
+  - Rtc
+obsoleteVoid:
+  - Keygen
+  - Param
 ---
-package gel
 {{ .GEN_TAGLINE }}
 
+package gel
+
 var (
   // Normal tags requiring closing tag.
   {{ range .normal }}{{ . }} Tag = El("{{ . | lower }}", false)
@@ -120,4 +132,29 @@ var (
   // Void elements that must be self closed.
   {{ range .void }}{{ . }} Tag = El("{{ . | lower }}", true)
   {{ end }}
+  {{ range .obsolete }}
+  // Deprecated: {{ . }} is obsolete in the html living standard.
+  {{ . }} Tag = El("{{ . | lower }}", false)
+  {{ end }}
+  {{ range .obsoleteVoid }}
+  // Deprecated: {{ . }} is obsolete in the html living standard.
+  {{ . }} Tag = El("{{ . | lower }}", true)
+  {{ end }}
 )
+
+// Tags maps each element name to its Tag.
+var Tags = map[string]Tag{
+  {{ range .normal }}"{{ . | lower }}": {{ . }},
+  {{ end }}{{ range .void }}"{{ . | lower }}": {{ . }},
+  {{ end }}{{ range .obsolete }}"{{ . | lower }}": {{ . }},
+  {{ end }}{{ range .obsoleteVoid }}"{{ . | lower }}": {{ . }},
+  {{ end }}
+}
+
+// voidElements are the elements that can't have children and have no end
+// tag.
+var voidElements = map[string]bool{
+  {{ range .void }}"{{ . | lower }}": true,
+  {{ end }}{{ range .obsoleteVoid }}"{{ . | lower }}": true,
+  {{ end }}
+}
@@ -10,4 +10,9 @@ const (
 	Attribute     Type = 3
 	NodeList      Type = 4
 	AttributeList Type = 5
+	Markup        Type = 6
+	Hoisted       Type = 7
+	Comment       Type = 8
+	Streamed      Type = 9
+	Contextual    Type = 10
 )
@@ -4,9 +4,9 @@ package gel
 
 import "strconv"
 
-const _Type_name = "TextualElementAttributeNodeListAttributeList"
+const _Type_name = "TextualElementAttributeNodeListAttributeListMarkupHoistedCommentStreamedContextual"
 
-var _Type_index = [...]uint8{0, 7, 14, 23, 31, 44}
+var _Type_index = [...]uint8{0, 7, 14, 23, 31, 44, 50, 57, 64, 72, 82}
 
 func (i Type) String() string {
 	i -= 1
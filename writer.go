@@ -0,0 +1,164 @@
+package gel
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+)
+
+// bufferSize is the size of the buffers used to batch small writes to
+// writers that are not already buffered in memory.
+const bufferSize = 4096
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return bufio.NewWriterSize(nil, bufferSize)
+	},
+}
+
+// stringWriter is the set of methods used to write output, which the
+// in-memory writers and bufio.Writer all provide.
+type stringWriter interface {
+	io.StringWriter
+	io.ByteWriter
+}
+
+// writer sends output to a stringWriter, buffering it when the destination
+// is not already held in memory.  It counts the bytes delivered and holds on
+// to the first error, after which all writes are skipped.
+type writer struct {
+	dst stringWriter
+	buf *bufio.Writer
+	cnt counter
+	n   int64
+	err error
+}
+
+// newWriter wraps w, writing directly to in-memory writers and buffering
+// everything else.
+func newWriter(w io.Writer) *writer {
+	out := &writer{}
+	switch d := w.(type) {
+	case *bytes.Buffer:
+		out.dst = d
+	case *strings.Builder:
+		out.dst = d
+	case *bufio.Writer:
+		out.dst = d
+	default:
+		out.cnt.w = w
+		out.buf = bufferPool.Get().(*bufio.Writer)
+		out.buf.Reset(&out.cnt)
+		out.dst = out.buf
+	}
+	return out
+}
+
+// close flushes any buffered output and returns the number of bytes
+// written and the first error encountered.
+func (w *writer) close() (int64, error) {
+	if w.buf == nil {
+		return w.n, w.err
+	}
+	if w.err == nil {
+		w.err = w.buf.Flush()
+	}
+	w.buf.Reset(nil)
+	bufferPool.Put(w.buf)
+	w.buf = nil
+	return w.cnt.n, w.err
+}
+
+// fail stops all further writes, holding on to err unless a write has
+// already failed.
+func (w *writer) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// failed reports if a write has failed.
+func (w *writer) failed() bool {
+	return w.err != nil
+}
+
+func (w *writer) str(s string) {
+	if w.err != nil {
+		return
+	}
+	n, err := w.dst.WriteString(s)
+	w.n += int64(n)
+	w.err = err
+}
+
+func (w *writer) byte(c byte) {
+	if w.err != nil {
+		return
+	}
+	w.err = w.dst.WriteByte(c)
+	if w.err == nil {
+		w.n++
+	}
+}
+
+// escaped writes s applying the escaping for the given context.
+func (w *writer) escaped(esc Escaping, s string) {
+	switch esc {
+	case EscapeText:
+		w.replace(s, textEntity)
+	case EscapeAttr:
+		w.replace(s, attrEntity)
+	case EscapeURL:
+		w.replace(FilterURL(s), attrEntity)
+	default:
+		w.str(Escape(esc, s))
+	}
+}
+
+// replace writes s substituting each byte for which entity returns a
+// non-empty replacement.
+func (w *writer) replace(s string, entity func(byte) string) {
+	last := 0
+	for i := 0; i < len(s); i++ {
+		rep := entity(s[i])
+		if rep == "" {
+			continue
+		}
+		w.str(s[last:i])
+		w.str(rep)
+		last = i + 1
+	}
+	w.str(s[last:])
+}
+
+// Flusher is implemented by writers which can send their buffered output
+// on to its destination, such as an http.ResponseWriter.
+type Flusher interface {
+	Flush()
+}
+
+// flush writes the buffered output through to the destination and then
+// flushes the destination when it is a Flusher.
+func (w *writer) flush() {
+	if w.buf == nil || w.err != nil {
+		return
+	}
+	w.err = w.buf.Flush()
+	if f, ok := w.cnt.w.(Flusher); ok && w.err == nil {
+		f.Flush()
+	}
+}
+
+// counter counts the bytes written through to the underlying writer.
+type counter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *counter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}